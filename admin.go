@@ -0,0 +1,598 @@
+package server
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/conflux.v2/recon"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+// adminError is the JSON body of an admin API error response.
+type adminError struct {
+	Error string `json:"error"`
+}
+
+func adminRespond(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Errorf("failed to write admin response: %v", err)
+	}
+}
+
+func adminFail(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch errgo.Cause(err) {
+	case ErrPartnerNotFound, ErrNoTombstone, ErrVerificationDisabled:
+		status = http.StatusNotFound
+	case ErrPartnerExists, ErrPartnerPaused:
+		status = http.StatusConflict
+	case errBadRequest, ErrInvalidFingerprint:
+		status = http.StatusBadRequest
+	}
+	if status == http.StatusInternalServerError {
+		log.Errorf("admin: %v", errgo.Details(err))
+	}
+	adminRespond(w, status, &adminError{Error: err.Error()})
+}
+
+var errBadRequest = errgo.New("bad request")
+
+// adminAuth requires requests to present the admin token as a bearer token,
+// or a verified TLS client certificate.
+func adminAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 {
+			next.ServeHTTP(w, req)
+			return
+		}
+		auth := req.Header.Get("Authorization")
+		if token == "" || !strings.HasPrefix(auth, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="hockeypuck"`)
+			adminRespond(w, http.StatusUnauthorized, &adminError{Error: "unauthorized"})
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// adminIdentity describes how the client of an admin request authenticated,
+// for the audit log.
+func adminIdentity(req *http.Request) string {
+	if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 {
+		return "cert:" + req.TLS.VerifiedChains[0][0].Subject.CommonName
+	}
+	if req.Header.Get("Authorization") != "" {
+		return "token"
+	}
+	return "socket"
+}
+
+// registerAdmin registers the admin API endpoints.
+func (s *Server) registerAdmin(r *httprouter.Router) {
+	r.GET("/partners", s.adminListPartners)
+	r.POST("/partners", s.adminAddPartner)
+	r.DELETE("/partners/:name", s.adminRemovePartner)
+	r.POST("/partners/:name/pause", s.adminPartnerAction((*reconPeer).Pause))
+	r.POST("/partners/:name/resume", s.adminPartnerAction((*reconPeer).Resume))
+	r.POST("/partners/:name/recon", s.adminPartnerAction((*reconPeer).ReconNow))
+
+	r.GET("/log/level", s.adminGetLogLevel)
+	r.PUT("/log/level", s.adminSetLogLevel)
+	r.POST("/log/rotate", s.adminLogRotate)
+
+	r.GET("/profile/cpu", s.adminCPUProfile)
+	r.POST("/profile/cpu/start", s.adminStartCPUProfile)
+	r.POST("/profile/cpu/stop", s.adminStopCPUProfile)
+	r.POST("/profile/heap", s.adminHeapProfile)
+
+	r.GET("/storage/stats", s.adminStorageStats)
+	r.GET("/storage/strip", s.adminStripStatus)
+	r.POST("/storage/strip", s.adminStartStrip)
+
+	r.DELETE("/keys/:fingerprint", s.adminDeleteKey)
+	r.GET("/tombstones", s.adminListTombstones)
+	r.GET("/tombstones/audit", s.adminTombstoneAudit)
+	r.DELETE("/tombstones/:fingerprint", s.adminRestoreKey)
+
+	r.GET("/verifications/:fingerprint", s.adminListVerifications)
+	r.DELETE("/verifications/:fingerprint", s.adminRevokeVerifications)
+
+	if s.journal != nil {
+		r.GET("/changes", s.serveChanges)
+		r.GET("/journal", s.serveJournal)
+	}
+
+	pprofHandler := PprofHandler()
+	r.Handler("GET", "/debug/pprof/*profile", pprofHandler)
+	r.Handler("POST", "/debug/pprof/*profile", pprofHandler)
+}
+
+func (s *Server) adminHandler() http.Handler {
+	r := httprouter.New()
+	s.registerAdmin(r)
+	return r
+}
+
+func (s *Server) listenAndServeAdmin() error {
+	if s.settings.Admin.Token != "" && s.settings.Admin.Cert == "" && !isLoopback(s.settings.Admin.Bind) {
+		return errgo.Newf("refusing to accept the admin token without TLS on non-loopback address %q; set admin cert and key", s.settings.Admin.Bind)
+	}
+	ln, err := newListener(s, s.settings.Admin.Bind)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if s.settings.Admin.Cert != "" {
+		config, err := adminTLSConfig(s.settings.Admin)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		ln = tls.NewListener(ln, config)
+	}
+	s.adminAddr = ln.Addr().String()
+	return http.Serve(ln, adminAuth(s.settings.Admin.Token, s.adminHandler()))
+}
+
+func adminTLSConfig(settings *AdminConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(settings.Cert, settings.Key)
+	if err != nil {
+		return nil, errgo.Notef(err, "failed to load admin certificate=%q key=%q", settings.Cert, settings.Key)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if settings.ClientCA != "" {
+		pem, err := ioutil.ReadFile(settings.ClientCA)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, errgo.Newf("no certificates found in admin clientCA %q", settings.ClientCA)
+		}
+		// Clients without a certificate may still authenticate with the
+		// token.
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
+}
+
+// listenAndServeAdminSocket serves the admin API on a unix socket, which is
+// only accessible to its owner.
+func (s *Server) listenAndServeAdminSocket() error {
+	path := s.settings.Admin.Socket
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errgo.Notef(err, "cannot remove stale admin socket %q", path)
+	}
+	ln, err := listenPrivateSocket(path)
+	if err != nil {
+		return errgo.Notef(err, "cannot listen on admin socket %q", path)
+	}
+	s.t.Go(func() error {
+		<-s.t.Dying()
+		defer os.Remove(path)
+		return ln.Close()
+	})
+	return http.Serve(ln, s.adminHandler())
+}
+
+// listenPrivateSocket listens on a unix socket at path which only its owner
+// may connect to. The socket is created in a directory only accessible to
+// its owner, and restricted before it is moved into place, so that it is
+// never accessible to others.
+func listenPrivateSocket(path string) (net.Listener, error) {
+	dir, err := ioutil.TempDir(filepath.Dir(path), ".hockeypuck-admin")
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	defer os.RemoveAll(dir)
+	tmpPath := filepath.Join(dir, "socket")
+	ln, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	// The socket is removed from its final path when the server stops.
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+	err = os.Chmod(tmpPath, 0600)
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		ln.Close()
+		return nil, errgo.Mask(err)
+	}
+	return ln, nil
+}
+
+type adminPartner struct {
+	partnerInfo
+	Status *partnerStatus `json:"status"`
+}
+
+func (s *Server) adminListPartners(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	result := []adminPartner{}
+	for _, info := range s.sksPeer.List() {
+		result = append(result, adminPartner{
+			partnerInfo: info,
+			Status:      s.partners.Status(info.Name),
+		})
+	}
+	adminRespond(w, http.StatusOK, result)
+}
+
+func (s *Server) adminAddPartner(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var body struct {
+		Name string `json:"name"`
+		recon.Partner
+	}
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		adminFail(w, errgo.WithCausef(err, errBadRequest, "invalid partner"))
+		return
+	}
+	err = s.sksPeer.Add(body.Name, body.Partner)
+	if err != nil {
+		if cause := errgo.Cause(err); cause != ErrPartnerExists {
+			err = errgo.WithCausef(err, errBadRequest, "cannot add partner %q", body.Name)
+		}
+		adminFail(w, err)
+		return
+	}
+	s.partners.invalidate()
+	log.Infof("admin: added recon partner %q", body.Name)
+	adminRespond(w, http.StatusCreated, map[string]string{"name": body.Name})
+}
+
+func (s *Server) adminRemovePartner(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	name := ps.ByName("name")
+	err := s.sksPeer.Remove(name)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	s.partners.invalidate()
+	log.Infof("admin: removed recon partner %q", name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// adminPartnerAction returns a handler which applies f to the named
+// partner.
+func (s *Server) adminPartnerAction(f func(*reconPeer, string) error) httprouter.Handle {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		name := ps.ByName("name")
+		err := f(s.sksPeer, name)
+		if err != nil {
+			adminFail(w, err)
+			return
+		}
+		log.Infof("admin: %s recon partner %q", req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:], name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+type adminLogLevel struct {
+	Level string `json:"level"`
+}
+
+func (s *Server) adminGetLogLevel(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	adminRespond(w, http.StatusOK, &adminLogLevel{Level: log.GetLevel().String()})
+}
+
+func (s *Server) adminSetLogLevel(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var body adminLogLevel
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		adminFail(w, errgo.WithCausef(err, errBadRequest, "invalid log level"))
+		return
+	}
+	level, err := log.ParseLevel(strings.ToLower(body.Level))
+	if err != nil {
+		adminFail(w, errgo.WithCausef(err, errBadRequest, "invalid log level %q", body.Level))
+		return
+	}
+	log.SetLevel(level)
+	// Keep the new level when the log is reopened.
+	s.setLogLevel(body.Level)
+	log.Infof("admin: log level set to %s", level)
+	adminRespond(w, http.StatusOK, &adminLogLevel{Level: level.String()})
+}
+
+func (s *Server) adminLogRotate(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	s.LogRotate()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type adminProfile struct {
+	Running bool   `json:"running"`
+	Path    string `json:"path,omitempty"`
+	Started string `json:"started,omitempty"`
+}
+
+func (s *Server) profilePath(kind string) string {
+	dir := s.settings.Admin.ProfileDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, fmt.Sprintf("hockeypuck-%s-%s.prof", kind, time.Now().UTC().Format("20060102T150405Z")))
+}
+
+func (s *Server) adminCPUProfile(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	result := &adminProfile{}
+	if path, started, ok := CPUProfiling(); ok {
+		result.Running, result.Path = true, path
+		result.Started = started.UTC().Format(time.RFC3339)
+	}
+	adminRespond(w, http.StatusOK, result)
+}
+
+func (s *Server) adminStartCPUProfile(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	err := StartCPUProfile(s.profilePath("cpu"))
+	if errgo.Cause(err) == ErrProfiling {
+		adminRespond(w, http.StatusConflict, &adminError{Error: err.Error()})
+		return
+	} else if err != nil {
+		adminFail(w, err)
+		return
+	}
+	s.adminCPUProfile(w, req, ps)
+}
+
+func (s *Server) adminStopCPUProfile(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	path, err := StopCPUProfile()
+	if errgo.Cause(err) == ErrProfiling {
+		adminRespond(w, http.StatusConflict, &adminError{Error: err.Error()})
+		return
+	} else if err != nil {
+		adminFail(w, err)
+		return
+	}
+	adminRespond(w, http.StatusOK, &adminProfile{Path: path})
+}
+
+func (s *Server) adminHeapProfile(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	path := s.profilePath("heap")
+	err := WriteHeapProfile(path)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	adminRespond(w, http.StatusOK, &adminProfile{Path: path})
+}
+
+type adminStorageStats struct {
+	Driver string `json:"driver"`
+
+	// Keys is the number of keys in the prefix tree.
+	Keys     int           `json:"keys"`
+	LastHour *sks.LoadStat `json:"lastHour,omitempty"`
+	LastDay  *sks.LoadStat `json:"lastDay,omitempty"`
+
+	PrefixTreeBytes int64 `json:"prefixTreeBytes"`
+	JournalBytes    int64 `json:"journalBytes,omitempty"`
+
+	PKSQueue     *int `json:"pksQueue,omitempty"`
+	WebhookQueue *int `json:"webhookQueue,omitempty"`
+
+	// Tombstones is the number of deleted keys.
+	Tombstones int `json:"tombstones"`
+}
+
+// latestLoadStat returns the most recent entry in m.
+func latestLoadStat(m sks.LoadStatMap) *sks.LoadStat {
+	var latest time.Time
+	var result *sks.LoadStat
+	for t, stat := range m {
+		if result == nil || t.After(latest) {
+			latest, result = t, stat
+		}
+	}
+	return result
+}
+
+// dirSize returns the total size of the regular files under dir.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+	return size, errgo.Mask(err)
+}
+
+func spoolLen(sp *spool) (*int, error) {
+	names, err := sp.List()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	n := len(names)
+	return &n, nil
+}
+
+func (s *Server) adminStorageStats(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	sksStats := s.sksPeer.Stats()
+	result := &adminStorageStats{
+		Driver:     s.settings.OpenPGP.DB.Driver,
+		Keys:       sksStats.Total,
+		LastHour:   latestLoadStat(sksStats.Hourly),
+		LastDay:    latestLoadStat(sksStats.Daily),
+		Tombstones: len(s.ingestion.Tombstones.List()),
+	}
+	var err error
+	result.PrefixTreeBytes, err = dirSize(s.settings.Conflux.Recon.LevelDB.Path)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	if s.journal != nil {
+		result.JournalBytes, err = dirSize(s.settings.Journal.Dir)
+		if err != nil {
+			adminFail(w, err)
+			return
+		}
+	}
+	if s.pks != nil {
+		result.PKSQueue, err = spoolLen(s.pks.spool)
+		if err != nil {
+			adminFail(w, err)
+			return
+		}
+	}
+	if s.webhooks != nil {
+		result.WebhookQueue, err = s.webhooks.queueLen()
+		if err != nil {
+			adminFail(w, err)
+			return
+		}
+	}
+	adminRespond(w, http.StatusOK, result)
+}
+
+// adminTakedown is the optional JSON body of a key deletion or restoration
+// request. Actor names the person on whose behalf the request is made; it is
+// recorded in the audit log along with how the request was authenticated.
+type adminTakedown struct {
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+}
+
+func readAdminTakedown(req *http.Request) (*adminTakedown, string, error) {
+	var body adminTakedown
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil && err != io.EOF {
+		return nil, "", errgo.WithCausef(err, errBadRequest, "invalid request")
+	}
+	actor := adminIdentity(req)
+	if body.Actor != "" {
+		actor = body.Actor + " (" + actor + ")"
+	}
+	return &body, actor, nil
+}
+
+func (s *Server) adminDeleteKey(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	body, actor, err := readAdminTakedown(req)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	ts, err := s.takedown.Delete(ps.ByName("fingerprint"), actor, body.Reason)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	adminRespond(w, http.StatusOK, ts)
+}
+
+func (s *Server) adminListTombstones(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	result := []Tombstone{}
+	result = append(result, s.ingestion.Tombstones.List()...)
+	adminRespond(w, http.StatusOK, result)
+}
+
+func (s *Server) adminTombstoneAudit(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	result := []*TombstoneAudit{}
+	err := s.ingestion.Tombstones.AuditLog(func(entry *TombstoneAudit) error {
+		result = append(result, entry)
+		return nil
+	})
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	adminRespond(w, http.StatusOK, result)
+}
+
+func (s *Server) adminRestoreKey(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	body, actor, err := readAdminTakedown(req)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	err = s.takedown.Restore(ps.ByName("fingerprint"), actor, body.Reason)
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) adminStripStatus(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	adminRespond(w, http.StatusOK, s.stripper.Status())
+}
+
+// adminStartStrip starts rewriting the keys in storage without their
+// third-party certifications. The job runs in the background; its progress
+// is reported by GET /storage/strip.
+func (s *Server) adminStartStrip(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var body struct {
+		DryRun bool `json:"dryRun"`
+	}
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil && err != io.EOF {
+		adminFail(w, errgo.WithCausef(err, errBadRequest, "invalid request"))
+		return
+	}
+	err = s.stripper.Start(body.DryRun)
+	if errgo.Cause(err) == ErrStripRunning {
+		adminRespond(w, http.StatusConflict, &adminError{Error: err.Error()})
+		return
+	} else if err != nil {
+		adminFail(w, err)
+		return
+	}
+	log.Infof("certification stripping started by %s", adminIdentity(req))
+	adminRespond(w, http.StatusAccepted, s.stripper.Status())
+}
+
+func (s *Server) adminListVerifications(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	if s.verifier == nil {
+		adminFail(w, ErrVerificationDisabled)
+		return
+	}
+	result := []*Verification{}
+	vers, err := s.verifier.Verifications(ps.ByName("fingerprint"))
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	result = append(result, vers...)
+	adminRespond(w, http.StatusOK, result)
+}
+
+// adminRevokeVerifications revokes the publication of the email address
+// given by the email query parameter with a key, or of all of its addresses
+// if it is not given.
+func (s *Server) adminRevokeVerifications(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	if s.verifier == nil {
+		adminFail(w, ErrVerificationDisabled)
+		return
+	}
+	n, err := s.verifier.Revoke(ps.ByName("fingerprint"), req.URL.Query().Get("email"), adminIdentity(req))
+	if err != nil {
+		adminFail(w, err)
+		return
+	}
+	adminRespond(w, http.StatusOK, map[string]int{"revoked": n})
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenPrivateSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "admin.sock")
+
+	ln, err := listenPrivateSocket(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := fi.Mode().Perm(); perm != 0600 {
+		t.Errorf("got socket mode %v, want 0600", perm)
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("got %d entries in socket directory, want only the socket", len(entries))
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"127.0.0.1:11372", true},
+		{"[::1]:11372", true},
+		{"localhost:11372", true},
+		{":11372", false},
+		{"0.0.0.0:11372", false},
+		{"192.0.2.1:11372", false},
+		{"127.0.0.1", false},
+	}
+	for _, test := range tests {
+		if got := isLoopback(test.addr); got != test.want {
+			t.Errorf("isLoopback(%q) = %v, want %v", test.addr, got, test.want)
+		}
+	}
+}
+
+func TestAdminRefusesTokenWithoutTLS(t *testing.T) {
+	s := &Server{settings: &Settings{Admin: &AdminConfig{Bind: "0.0.0.0:0", Token: "secret"}}}
+	err := s.listenAndServeAdmin()
+	if err == nil {
+		t.Fatal("admin listener started without TLS on a non-loopback address")
+	}
+}
@@ -0,0 +1,229 @@
+package server
+
+//go:generate go run assetsgen.go
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/errgo.v1"
+)
+
+// Names of the embedded assets. The default web UI is under www, and the
+// templates of the index and stats pages under templates.
+const (
+	assetsWebroot      = "www"
+	assetIndexTemplate = "templates/index.html.tmpl"
+	assetStatsTemplate = "templates/stats.html.tmpl"
+)
+
+// assets serves the assets embedded in the server, each of which may be
+// overridden by a file at the same path under dir.
+type assets struct {
+	dir string
+}
+
+func newAssets(dir string) *assets {
+	return &assets{dir: dir}
+}
+
+// diskPath returns the path of the file overriding the named asset, or "" if
+// overrides are not enabled.
+func (a *assets) diskPath(name string) string {
+	if a.dir == "" {
+		return ""
+	}
+	return filepath.Join(a.dir, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+// read returns the content of the named asset.
+func (a *assets) read(name string) ([]byte, error) {
+	if p := a.diskPath(name); p != "" {
+		buf, err := ioutil.ReadFile(p)
+		if err == nil {
+			return buf, nil
+		} else if !os.IsNotExist(err) {
+			return nil, errgo.Mask(err)
+		}
+	}
+	content, ok := embeddedAssets[name]
+	if !ok {
+		return nil, errgo.WithCausef(nil, os.ErrNotExist, "asset %q not found", name)
+	}
+	return []byte(content), nil
+}
+
+// extractTemplates writes the index and stats templates to a temporary
+// directory, as the HKP handler reads its templates from files, and returns
+// the paths of the index and stats templates within it.
+func (a *assets) extractTemplates() (string, string, error) {
+	dir, err := ioutil.TempDir("", "hockeypuck-templates")
+	if err != nil {
+		return "", "", errgo.Mask(err)
+	}
+	var paths []string
+	for _, name := range []string{assetIndexTemplate, assetStatsTemplate} {
+		buf, err := a.read(name)
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", "", errgo.Mask(err)
+		}
+		p := filepath.Join(dir, path.Base(name))
+		err = ioutil.WriteFile(p, buf, 0644)
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", "", errgo.Mask(err)
+		}
+		paths = append(paths, p)
+	}
+	return paths[0], paths[1], nil
+}
+
+// entries returns the assets and directories of assets directly within the
+// named directory, embedded or overriding.
+func (a *assets) entries(dir string) []os.FileInfo {
+	byName := make(map[string]os.FileInfo)
+	if p := a.diskPath(dir); p != "" {
+		if fis, err := ioutil.ReadDir(p); err == nil {
+			for _, fi := range fis {
+				byName[fi.Name()] = fi
+			}
+		}
+	}
+	prefix := dir + "/"
+	for name, content := range embeddedAssets {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rest := name[len(prefix):]
+		if i := strings.Index(rest, "/"); i >= 0 {
+			if _, ok := byName[rest[:i]]; !ok {
+				byName[rest[:i]] = &assetInfo{name: rest[:i], dir: true}
+			}
+		} else if _, ok := byName[rest]; !ok {
+			byName[rest] = &assetInfo{name: rest, size: int64(len(content))}
+		}
+	}
+	result := make([]os.FileInfo, 0, len(byName))
+	for _, fi := range byName {
+		result = append(result, fi)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result
+}
+
+// FileSystem returns the assets under the named directory as an
+// http.FileSystem.
+func (a *assets) FileSystem(dir string) http.FileSystem {
+	return &assetFileSystem{assets: a, root: dir}
+}
+
+type assetFileSystem struct {
+	assets *assets
+	root   string
+}
+
+func (fs *assetFileSystem) Open(name string) (http.File, error) {
+	name = strings.TrimSuffix(fs.root+path.Clean("/"+name), "/")
+	if p := fs.assets.diskPath(name); p != "" {
+		f, err := os.Open(p)
+		if err == nil {
+			return f, nil
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	if content, ok := embeddedAssets[name]; ok {
+		return &assetFile{
+			Reader: bytes.NewReader([]byte(content)),
+			info:   &assetInfo{name: path.Base(name), size: int64(len(content))},
+		}, nil
+	}
+	for asset := range embeddedAssets {
+		if strings.HasPrefix(asset, name+"/") {
+			return &assetFile{
+				Reader: bytes.NewReader(nil),
+				info:   &assetInfo{name: path.Base(name), dir: true},
+			}, nil
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+// assetFile is an embedded asset, or a directory of them, opened for
+// serving. Directories are not listed.
+type assetFile struct {
+	*bytes.Reader
+	info *assetInfo
+}
+
+func (f *assetFile) Close() error {
+	return nil
+}
+
+func (f *assetFile) Readdir(int) ([]os.FileInfo, error) {
+	return nil, nil
+}
+
+func (f *assetFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+type assetInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (fi *assetInfo) Name() string       { return fi.name }
+func (fi *assetInfo) Size() int64        { return fi.size }
+func (fi *assetInfo) ModTime() time.Time { return time.Time{} }
+func (fi *assetInfo) IsDir() bool        { return fi.dir }
+func (fi *assetInfo) Sys() interface{}   { return nil }
+
+func (fi *assetInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+// ExtractAssets writes the embedded web UI and templates under dir, so that
+// they may be customized and used to override the embedded assets with the
+// assetsDir setting. Existing files are only overwritten if force is set.
+func ExtractAssets(dir string, force bool) ([]string, error) {
+	var names []string
+	for name := range embeddedAssets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if !force {
+		for _, name := range names {
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if _, err := os.Stat(p); err == nil {
+				return nil, errgo.Newf("%q already exists", p)
+			}
+		}
+	}
+	var written []string
+	for _, name := range names {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		err := os.MkdirAll(filepath.Dir(p), 0755)
+		if err != nil {
+			return written, errgo.Mask(err)
+		}
+		err = ioutil.WriteFile(p, []byte(embeddedAssets[name]), 0644)
+		if err != nil {
+			return written, errgo.Mask(err)
+		}
+		written = append(written, p)
+	}
+	return written, nil
+}
@@ -0,0 +1,73 @@
+//go:build ignore
+// +build ignore
+
+// Command assetsgen generates assets_gen.go, which embeds the default web UI
+// and templates from the snap directory in the server.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// roots maps the directories assets are read from to the names they are
+// embedded under.
+var roots = map[string]string{
+	"snap/www":       "www",
+	"snap/templates": "templates",
+}
+
+func main() {
+	assets := make(map[string][]byte)
+	for dir, prefix := range roots {
+		err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil || fi.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			assets[prefix+"/"+filepath.ToSlash(rel)] = buf
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	var names []string
+	for name := range assets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by assetsgen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package server")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var embeddedAssets = map[string]string{")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %s,\n", name, strconv.Quote(string(assets[name])))
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("assets_gen.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,378 @@
+package server
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// KeyBackend provides the operations on the storage backend which the
+// storage interface does not provide for. A server dials one KeyBackend,
+// shared by the components which need it, and closes it when it stops.
+type KeyBackend interface {
+	// Delete deletes the key with the given reversed fingerprint, returning
+	// its digest, or "" if there was no such key.
+	Delete(rfp string) (string, error)
+
+	// Fingerprints calls f with the reversed fingerprint of every key in
+	// storage.
+	Fingerprints(f func(rfp string) error) error
+
+	// PutVerification stores v, replacing any verification with the same
+	// token.
+	PutVerification(v *Verification) error
+
+	// Verification returns the verification with the given token, or nil
+	// if there is none.
+	Verification(token string) (*Verification, error)
+
+	// Verifications returns the verifications of the given kind for a key
+	// fingerprint and email address, either of which may be empty to match
+	// any.
+	Verifications(kind, fingerprint, email string) ([]*Verification, error)
+
+	// DeleteVerifications deletes the verifications of the given kind for a
+	// key fingerprint and email address, either of which may be empty to
+	// match any, returning the number deleted.
+	DeleteVerifications(kind, fingerprint, email string) (int, error)
+
+	// ExpireVerifications deletes unconfirmed verifications created before
+	// the given time, returning the number deleted.
+	ExpireVerifications(before time.Time) (int, error)
+
+	// InitVerifications creates the table or index holding verifications,
+	// if it does not already exist. It must be called before verifications
+	// are stored.
+	InitVerifications() error
+
+	Close() error
+}
+
+// Default database and collection names used by mgohkp.
+const (
+	defaultMongoDB         = "hkp"
+	defaultMongoCollection = "keys"
+)
+
+// verificationsCollection is the MongoDB collection holding user ID
+// verifications.
+const verificationsCollection = "verifications"
+
+// DialKeyBackend connects to the storage backend configured in settings.
+func DialKeyBackend(settings *Settings) (KeyBackend, error) {
+	switch settings.OpenPGP.DB.Driver {
+	case "mongo":
+		session, err := mgo.Dial(settings.OpenPGP.DB.DSN)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		d := &mongoBackend{session: session, db: defaultMongoDB, collection: defaultMongoCollection}
+		if mongo := settings.OpenPGP.DB.Mongo; mongo != nil {
+			if mongo.DB != "" {
+				d.db = mongo.DB
+			}
+			if mongo.Collection != "" {
+				d.collection = mongo.Collection
+			}
+		}
+		return d, nil
+	case "postgres-jsonb":
+		db, err := sql.Open("postgres", settings.OpenPGP.DB.DSN)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		return &pgBackend{db: db}, nil
+	}
+	return nil, errgo.Newf("storage driver %q not supported", settings.OpenPGP.DB.Driver)
+}
+
+type mongoBackend struct {
+	session        *mgo.Session
+	db, collection string
+}
+
+func (d *mongoBackend) Delete(rfp string) (string, error) {
+	c := d.session.DB(d.db).C(d.collection)
+	var doc struct {
+		MD5 string `bson:"md5"`
+	}
+	err := c.Find(bson.M{"rfingerprint": rfp}).Select(bson.M{"md5": 1}).One(&doc)
+	if err == mgo.ErrNotFound {
+		return "", nil
+	} else if err != nil {
+		return "", errgo.Mask(err)
+	}
+	err = c.Remove(bson.M{"rfingerprint": rfp})
+	if err == mgo.ErrNotFound {
+		return "", nil
+	} else if err != nil {
+		return "", errgo.Mask(err)
+	}
+	return doc.MD5, nil
+}
+
+func (d *mongoBackend) Fingerprints(f func(rfp string) error) error {
+	iter := d.session.DB(d.db).C(d.collection).Find(nil).Select(bson.M{"rfingerprint": 1}).Iter()
+	var doc struct {
+		RFingerprint string `bson:"rfingerprint"`
+	}
+	for iter.Next(&doc) {
+		err := f(doc.RFingerprint)
+		if err != nil {
+			iter.Close()
+			return errgo.Mask(err, errgo.Any)
+		}
+	}
+	return errgo.Mask(iter.Close())
+}
+
+// mongoVerification is the document stored for a verification. Verified is
+// the zero time until the verification is confirmed.
+type mongoVerification struct {
+	Token       string    `bson:"token"`
+	Kind        string    `bson:"kind"`
+	Fingerprint string    `bson:"fingerprint"`
+	Email       string    `bson:"email"`
+	Created     time.Time `bson:"ctime"`
+	Verified    time.Time `bson:"vtime"`
+}
+
+func verificationSelector(kind, fingerprint, email string) bson.M {
+	sel := bson.M{"kind": kind}
+	if fingerprint != "" {
+		sel["fingerprint"] = fingerprint
+	}
+	if email != "" {
+		sel["email"] = email
+	}
+	return sel
+}
+
+func (d *mongoBackend) InitVerifications() error {
+	return errgo.Mask(d.session.DB(d.db).C(verificationsCollection).EnsureIndexKey("token"))
+}
+
+func (d *mongoBackend) PutVerification(v *Verification) error {
+	doc := mongoVerification(*v)
+	_, err := d.session.DB(d.db).C(verificationsCollection).Upsert(bson.M{"token": v.Token}, &doc)
+	return errgo.Mask(err)
+}
+
+func (d *mongoBackend) Verification(token string) (*Verification, error) {
+	var doc mongoVerification
+	err := d.session.DB(d.db).C(verificationsCollection).Find(bson.M{"token": token}).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	v := Verification(doc)
+	return &v, nil
+}
+
+func (d *mongoBackend) Verifications(kind, fingerprint, email string) ([]*Verification, error) {
+	var docs []mongoVerification
+	err := d.session.DB(d.db).C(verificationsCollection).Find(verificationSelector(kind, fingerprint, email)).All(&docs)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	result := make([]*Verification, len(docs))
+	for i := range docs {
+		v := Verification(docs[i])
+		result[i] = &v
+	}
+	return result, nil
+}
+
+func (d *mongoBackend) DeleteVerifications(kind, fingerprint, email string) (int, error) {
+	info, err := d.session.DB(d.db).C(verificationsCollection).RemoveAll(verificationSelector(kind, fingerprint, email))
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	return info.Removed, nil
+}
+
+func (d *mongoBackend) ExpireVerifications(before time.Time) (int, error) {
+	info, err := d.session.DB(d.db).C(verificationsCollection).RemoveAll(bson.M{
+		"vtime": time.Time{},
+		"ctime": bson.M{"$lt": before},
+	})
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	return info.Removed, nil
+}
+
+func (d *mongoBackend) Close() error {
+	d.session.Close()
+	return nil
+}
+
+type pgBackend struct {
+	db *sql.DB
+}
+
+func (d *pgBackend) Delete(rfp string) (string, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec("DELETE FROM subkeys WHERE rfingerprint = $1", rfp)
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	var digest string
+	err = tx.QueryRow("DELETE FROM keys WHERE rfingerprint = $1 RETURNING md5", rfp).Scan(&digest)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", errgo.Mask(err)
+	}
+	return digest, errgo.Mask(tx.Commit())
+}
+
+func (d *pgBackend) Fingerprints(f func(rfp string) error) error {
+	rows, err := d.db.Query("SELECT rfingerprint FROM keys")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rfp string
+		err := rows.Scan(&rfp)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		err = f(rfp)
+		if err != nil {
+			return errgo.Mask(err, errgo.Any)
+		}
+	}
+	return errgo.Mask(rows.Err())
+}
+
+// pgVerificationsSchema creates the table holding verifications, if it does
+// not already exist. vtime is null until the verification is confirmed.
+var pgVerificationsSchema = []string{
+	`CREATE TABLE IF NOT EXISTS verifications (
+token TEXT NOT NULL PRIMARY KEY,
+kind TEXT NOT NULL,
+fingerprint TEXT NOT NULL,
+email TEXT NOT NULL,
+ctime TIMESTAMP WITH TIME ZONE NOT NULL,
+vtime TIMESTAMP WITH TIME ZONE
+)`,
+	`CREATE INDEX IF NOT EXISTS verifications_fingerprint ON verifications (fingerprint)`,
+	`CREATE INDEX IF NOT EXISTS verifications_email ON verifications (email)`,
+}
+
+// verificationWhere returns the WHERE clause and arguments selecting
+// verifications of the given kind for a fingerprint and email address.
+func verificationWhere(kind, fingerprint, email string) (string, []interface{}) {
+	args := []interface{}{kind}
+	conds := []string{"kind = $1"}
+	if fingerprint != "" {
+		args = append(args, fingerprint)
+		conds = append(conds, fmt.Sprintf("fingerprint = $%d", len(args)))
+	}
+	if email != "" {
+		args = append(args, email)
+		conds = append(conds, fmt.Sprintf("email = $%d", len(args)))
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+func (d *pgBackend) InitVerifications() error {
+	for _, stmt := range pgVerificationsSchema {
+		_, err := d.db.Exec(stmt)
+		if err != nil {
+			return errgo.Notef(err, "cannot create verifications table")
+		}
+	}
+	return nil
+}
+
+func (d *pgBackend) PutVerification(v *Verification) error {
+	var vtime interface{}
+	if !v.Verified.IsZero() {
+		vtime = v.Verified
+	}
+	_, err := d.db.Exec(`INSERT INTO verifications (token, kind, fingerprint, email, ctime, vtime)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (token) DO UPDATE SET vtime = EXCLUDED.vtime`,
+		v.Token, v.Kind, v.Fingerprint, v.Email, v.Created, vtime)
+	return errgo.Mask(err)
+}
+
+const pgVerificationColumns = "SELECT token, kind, fingerprint, email, ctime, vtime FROM verifications"
+
+func scanVerification(scan func(dest ...interface{}) error) (*Verification, error) {
+	var v Verification
+	var vtime *time.Time
+	err := scan(&v.Token, &v.Kind, &v.Fingerprint, &v.Email, &v.Created, &vtime)
+	if err != nil {
+		return nil, err
+	}
+	if vtime != nil {
+		v.Verified = *vtime
+	}
+	return &v, nil
+}
+
+func (d *pgBackend) Verification(token string) (*Verification, error) {
+	v, err := scanVerification(d.db.QueryRow(pgVerificationColumns+" WHERE token = $1", token).Scan)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return v, nil
+}
+
+func (d *pgBackend) Verifications(kind, fingerprint, email string) ([]*Verification, error) {
+	where, args := verificationWhere(kind, fingerprint, email)
+	rows, err := d.db.Query(pgVerificationColumns+where, args...)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	defer rows.Close()
+	var result []*Verification
+	for rows.Next() {
+		v, err := scanVerification(rows.Scan)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		result = append(result, v)
+	}
+	return result, errgo.Mask(rows.Err())
+}
+
+func (d *pgBackend) DeleteVerifications(kind, fingerprint, email string) (int, error) {
+	where, args := verificationWhere(kind, fingerprint, email)
+	result, err := d.db.Exec("DELETE FROM verifications"+where, args...)
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	n, err := result.RowsAffected()
+	return int(n), errgo.Mask(err)
+}
+
+func (d *pgBackend) ExpireVerifications(before time.Time) (int, error) {
+	result, err := d.db.Exec("DELETE FROM verifications WHERE vtime IS NULL AND ctime < $1", before)
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	n, err := result.RowsAffected()
+	return int(n), errgo.Mask(err)
+}
+
+func (d *pgBackend) Close() error {
+	return errgo.Mask(d.db.Close())
+}
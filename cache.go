@@ -0,0 +1,400 @@
+package server
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// CacheStats reports the effectiveness of the lookup cache.
+type CacheStats struct {
+	Entries       int    `json:"entries"`
+	Bytes         int    `json:"bytes"`
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Invalidations uint64 `json:"invalidations"`
+}
+
+// cacheEntry is a cached lookup response.
+type cacheEntry struct {
+	key      string
+	header   http.Header
+	body     []byte
+	etag     string
+	modified time.Time
+	expires  time.Time
+
+	// digests and rfps are those of the keys in the response, by which the
+	// entry is invalidated.
+	digests []string
+	rfps    []string
+}
+
+// lookupCache is middleware which caches the responses to HKP get lookups
+// by key ID or fingerprint, so that popular keys are not fetched from
+// storage for every request. Entries are invalidated when the keys in them
+// change or the publication of their user IDs changes, and otherwise expire
+// after the configured age, which bounds how long keys with colliding key
+// IDs may go unseen.
+//
+// Responses, whether cached or not, carry ETag, Last-Modified and
+// Cache-Control headers, and conditional requests are answered with 304 Not
+// Modified. Last-Modified is the time the response was cached, as a cached
+// response is replaced whenever its keys change.
+//
+// The keys in a response are those fetched by the lookup handler through
+// the view of storage returned by Storage, so caching a response does not
+// fetch them again.
+type lookupCache struct {
+	settings *CacheConfig
+
+	// st is the storage underlying all views, whose digests are those
+	// notified when keys change.
+	st storage.Storage
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	digests map[string]map[string]bool
+	rfps    map[string]map[string]bool
+	bytes   int
+
+	// lookups are those being served, which collect the keys fetched for
+	// them.
+	lookups map[*cacheLookup]bool
+
+	// gen is incremented by every invalidation, so that responses fetched
+	// while their keys changed are not cached.
+	gen uint64
+
+	hits, misses, invalidations uint64
+}
+
+func newLookupCache(settings *CacheConfig, st storage.Storage) *lookupCache {
+	c := &lookupCache{
+		settings: settings,
+		st:       st,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		digests:  make(map[string]map[string]bool),
+		rfps:     make(map[string]map[string]bool),
+		lookups:  make(map[*cacheLookup]bool),
+	}
+	st.Subscribe(c.keyChanged)
+	return c
+}
+
+func (c *lookupCache) maxAge() time.Duration {
+	return time.Duration(c.settings.MaxAgeSeconds) * time.Second
+}
+
+func (c *lookupCache) keyChanged(kc storage.KeyChange) error {
+	if kd, ok := kc.(KeyDeleted); ok {
+		c.InvalidateFingerprint(kd.Fingerprint)
+	}
+	_, oldDigest, _, ok := describeKeyChange(kc)
+	if ok && oldDigest != "" {
+		c.mu.Lock()
+		c.invalidate(c.digests[oldDigest])
+		c.mu.Unlock()
+	}
+	return nil
+}
+
+// InvalidateFingerprint removes the entries containing the key with the
+// given fingerprint.
+func (c *lookupCache) InvalidateFingerprint(fp string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(c.rfps[ReverseString(strings.ToLower(fp))])
+}
+
+// invalidate removes the entries with the given keys, taken from one of the
+// indexes of entries. It must be called with c.mu held.
+func (c *lookupCache) invalidate(keys map[string]bool) {
+	c.gen++
+	for key := range keys {
+		if elem, ok := c.entries[key]; ok {
+			c.remove(elem)
+			c.invalidations++
+		}
+	}
+}
+
+// remove removes an entry from the cache. It must be called with c.mu held.
+func (c *lookupCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+	for _, digest := range entry.digests {
+		delete(c.digests[digest], entry.key)
+		if len(c.digests[digest]) == 0 {
+			delete(c.digests, digest)
+		}
+	}
+	for _, rfp := range entry.rfps {
+		delete(c.rfps[rfp], entry.key)
+		if len(c.rfps[rfp]) == 0 {
+			delete(c.rfps, rfp)
+		}
+	}
+	c.bytes -= len(entry.body)
+}
+
+// lookupCacheKey returns the cache key of a request, and the key ID or
+// fingerprint it looks up, if it is a get lookup which may be cached.
+func lookupCacheKey(req *http.Request) (string, string, bool) {
+	if req.Method != "GET" || req.URL.Path != "/pks/lookup" {
+		return "", "", false
+	}
+	q := req.URL.Query()
+	if q.Get("op") != "get" {
+		return "", "", false
+	}
+	search := strings.ToLower(strings.Replace(q.Get("search"), " ", "", -1))
+	if !strings.HasPrefix(search, "0x") {
+		return "", "", false
+	}
+	id := search[2:]
+	if _, err := hex.DecodeString(id); err != nil {
+		return "", "", false
+	}
+	switch len(id) {
+	case 16, 40, 64:
+	default:
+		return "", "", false
+	}
+	return id + "\x00" + q.Get("options") + "\x00" + q.Get("exact"), id, true
+}
+
+func (c *lookupCache) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key, id, ok := lookupCacheKey(req)
+		if !ok {
+			next.ServeHTTP(w, req)
+			return
+		}
+		entry, gen := c.get(key)
+		if entry != nil {
+			c.serve(w, req, entry)
+			return
+		}
+
+		resp := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		lookup := c.startLookup(id)
+		next.ServeHTTP(resp, req)
+		c.endLookup(lookup)
+		if resp.status == http.StatusOK && len(lookup.digests) > 0 {
+			entry := c.newEntry(key, resp, lookup)
+			c.put(entry, gen)
+			c.serve(w, req, entry)
+			return
+		}
+		for name, values := range resp.header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(resp.status)
+		w.Write(resp.body.Bytes())
+	})
+}
+
+// get returns the unexpired entry with the given key, or nil and the
+// generation of the cache, which must be given to put its replacement.
+func (c *lookupCache) get(key string) (*cacheEntry, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			c.lru.MoveToFront(elem)
+			c.hits++
+			return entry, 0
+		}
+		c.remove(elem)
+	}
+	c.misses++
+	return nil, c.gen
+}
+
+// cacheLookup collects the keys fetched while a lookup of a key ID or
+// fingerprint is served.
+type cacheLookup struct {
+	// rid is the reversed key ID or fingerprint, which prefixes the
+	// reversed fingerprints of the keys it matches.
+	rid     string
+	digests []string
+	rfps    []string
+}
+
+func (c *lookupCache) startLookup(id string) *cacheLookup {
+	lookup := &cacheLookup{rid: ReverseString(id)}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lookups[lookup] = true
+	return lookup
+}
+
+func (c *lookupCache) endLookup(lookup *cacheLookup) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.lookups, lookup)
+}
+
+// fetched adds keys fetched from storage to the lookups they match.
+func (c *lookupCache) fetched(keys []*openpgp.PrimaryKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for lookup := range c.lookups {
+		for _, key := range keys {
+			if !strings.HasPrefix(key.RFingerprint, lookup.rid) || contains(lookup.rfps, key.RFingerprint) {
+				continue
+			}
+			lookup.digests = append(lookup.digests, key.MD5)
+			lookup.rfps = append(lookup.rfps, key.RFingerprint)
+		}
+	}
+}
+
+// Storage returns a view of st which reports the keys fetched by lookups
+// to the cache.
+func (c *lookupCache) Storage(st storage.Storage) storage.Storage {
+	return &cacheStorage{Storage: st, c: c}
+}
+
+type cacheStorage struct {
+	storage.Storage
+	c *lookupCache
+}
+
+func (st *cacheStorage) FetchKeys(rfps []string) ([]*openpgp.PrimaryKey, error) {
+	keys, err := st.Storage.FetchKeys(rfps)
+	st.c.fetched(keys)
+	return keys, err
+}
+
+func (st *cacheStorage) FetchKeyrings(rfps []string) ([]*storage.Keyring, error) {
+	keyrings, err := st.Storage.FetchKeyrings(rfps)
+	keys := make([]*openpgp.PrimaryKey, len(keyrings))
+	for i := range keyrings {
+		keys[i] = keyrings[i].PrimaryKey
+	}
+	st.c.fetched(keys)
+	return keyrings, err
+}
+
+// newEntry returns an entry holding a response to a lookup, with the
+// digests of the keys fetched to serve it.
+func (c *lookupCache) newEntry(key string, resp *bufferedResponse, lookup *cacheLookup) *cacheEntry {
+	body := resp.body.Bytes()
+	sum := sha256.Sum256(body)
+	now := time.Now()
+	entry := &cacheEntry{
+		key:      key,
+		header:   make(http.Header),
+		body:     body,
+		etag:     `"` + hex.EncodeToString(sum[:16]) + `"`,
+		modified: now.UTC().Truncate(time.Second),
+		expires:  now.Add(c.maxAge()),
+		digests:  lookup.digests,
+		rfps:     lookup.rfps,
+	}
+	for name, values := range resp.header {
+		if name != "Content-Length" {
+			entry.header[name] = values
+		}
+	}
+	return entry
+}
+
+// put adds entry to the cache, evicting the least recently used entries to
+// make room for it, unless the cache has been invalidated since generation
+// gen, when the entry may be stale.
+func (c *lookupCache) put(entry *cacheEntry, gen uint64) {
+	if len(entry.body) > c.settings.MaxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gen != gen {
+		return
+	}
+	if elem, ok := c.entries[entry.key]; ok {
+		c.remove(elem)
+	}
+	for c.lru.Len() > 0 && (c.lru.Len() >= c.settings.MaxEntries || c.bytes+len(entry.body) > c.settings.MaxBytes) {
+		c.remove(c.lru.Back())
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	for _, digest := range entry.digests {
+		if c.digests[digest] == nil {
+			c.digests[digest] = make(map[string]bool)
+		}
+		c.digests[digest][entry.key] = true
+	}
+	for _, rfp := range entry.rfps {
+		if c.rfps[rfp] == nil {
+			c.rfps[rfp] = make(map[string]bool)
+		}
+		c.rfps[rfp][entry.key] = true
+	}
+	c.bytes += len(entry.body)
+}
+
+// serve writes the response held by entry, or 304 Not Modified if the
+// request is conditional on a version the client already has.
+func (c *lookupCache) serve(w http.ResponseWriter, req *http.Request, entry *cacheEntry) {
+	for name, values := range entry.header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("ETag", entry.etag)
+	if !entry.modified.IsZero() {
+		w.Header().Set("Last-Modified", entry.modified.Format(http.TimeFormat))
+	}
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(c.settings.MaxAgeSeconds))
+	if notModified(req, entry) {
+		w.Header().Del("Content-Type")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(entry.body)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(entry.body)
+}
+
+// notModified returns whether req is conditional on entry having changed.
+// If-None-Match takes precedence over If-Modified-Since.
+func notModified(req *http.Request, entry *cacheEntry) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, etag := range strings.Split(inm, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == "*" || etag == entry.etag {
+				return true
+			}
+		}
+		return false
+	}
+	if ims, err := http.ParseTime(req.Header.Get("If-Modified-Since")); err == nil && !entry.modified.IsZero() {
+		return !entry.modified.After(ims)
+	}
+	return false
+}
+
+// Stats returns the cache's counters.
+func (c *lookupCache) Stats() *CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &CacheStats{
+		Entries:       c.lru.Len(),
+		Bytes:         c.bytes,
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Invalidations: c.invalidations,
+	}
+}
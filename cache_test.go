@@ -0,0 +1,54 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+func TestLookupCacheUsesFetchedKeys(t *testing.T) {
+	key := &openpgp.PrimaryKey{MD5: "digest1"}
+	key.RFingerprint = ReverseString(testVerifyFingerprint)
+	// testStorage has no Resolve or FetchKeyrings, so the cache must not
+	// fetch keys of its own.
+	st := newTestStorage(key)
+	c := newLookupCache(&CacheConfig{MaxEntries: 10, MaxBytes: 1 << 20, MaxAgeSeconds: 60}, st)
+	lookups := c.Storage(st)
+	var served int
+	h := c.middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		served++
+		keys, err := lookups.FetchKeys([]string{key.RFingerprint})
+		if err != nil || len(keys) != 1 {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(keys[0].MD5))
+	}))
+
+	get := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/pks/lookup?op=get&search=0x"+testVerifyFingerprint[24:], nil))
+		return rec
+	}
+	for i := 0; i < 2; i++ {
+		rec := get()
+		if rec.Code != http.StatusOK || rec.Body.String() != "digest1" {
+			t.Fatalf("got %d %q", rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("ETag") == "" || rec.Header().Get("Last-Modified") == "" {
+			t.Errorf("missing cache headers: %v", rec.Header())
+		}
+	}
+	if served != 1 {
+		t.Errorf("lookup served %d times, want once", served)
+	}
+
+	c.keyChanged(storage.KeyReplaced{OldDigest: "digest1", NewDigest: "digest2"})
+	get()
+	if served != 2 {
+		t.Errorf("lookup served %d times after the key changed, want twice", served)
+	}
+}
@@ -0,0 +1,378 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+const (
+	changesHeartbeat = 30 * time.Second
+
+	changesEventTruncated = "truncated"
+
+	contentTypeEventStream = "text/event-stream"
+	contentTypeNDJSON      = "application/x-ndjson"
+)
+
+// changesFilter selects the journal entries sent to a change stream
+// consumer.
+type changesFilter struct {
+	st      storage.Storage
+	keys    *keyFilter
+	events  map[string]bool
+	sources map[string]bool
+}
+
+// queryValues returns all values of a query parameter, which may be repeated
+// or comma-separated.
+func queryValues(req *http.Request, name string) []string {
+	var values []string
+	for _, v := range req.URL.Query()[name] {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+func newChangesFilter(st storage.Storage, req *http.Request) *changesFilter {
+	f := &changesFilter{
+		st: st,
+		keys: newKeyFilter(
+			queryValues(req, "fingerprint"),
+			queryValues(req, "keyid"),
+			queryValues(req, "domain"),
+		),
+	}
+	f.events = stringSet(queryValues(req, "event"))
+	f.sources = stringSet(queryValues(req, "source"))
+	return f
+}
+
+func (f *changesFilter) Match(entry *JournalEntry) bool {
+	if f.events != nil && !f.events[entry.Event] {
+		return false
+	}
+	if f.sources != nil && !f.sources[entry.Source] {
+		return false
+	}
+	if f.keys.empty() {
+		return true
+	}
+	if f.keys.matchFingerprint(entry.Fingerprint) {
+		return true
+	}
+	if len(f.keys.domains) == 0 {
+		return false
+	}
+	// Matching by domain requires the key's user IDs, which are not
+	// journaled. The key may have changed again since, in which case the
+	// later entry is matched instead.
+	rfps, err := f.st.MatchMD5([]string{entry.NewDigest})
+	if err != nil || len(rfps) == 0 {
+		return false
+	}
+	keys, err := f.st.FetchKeys(rfps[:1])
+	if err != nil || len(keys) == 0 {
+		return false
+	}
+	return f.keys.matchDomains(keys[0])
+}
+
+// changesWriter writes journal entries to a change stream consumer, either as
+// Server-Sent Events or newline-delimited JSON.
+type changesWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	sse     bool
+}
+
+func (cw *changesWriter) write(id int64, event string, v interface{}) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if cw.sse {
+		if id > 0 {
+			_, err = fmt.Fprintf(cw.w, "id: %d\n", id)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		_, err = fmt.Fprintf(cw.w, "event: %s\ndata: %s\n\n", event, buf)
+	} else {
+		_, err = fmt.Fprintf(cw.w, "%s\n", buf)
+	}
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	cw.flusher.Flush()
+	return nil
+}
+
+func (cw *changesWriter) heartbeat() error {
+	if !cw.sse {
+		return nil
+	}
+	_, err := fmt.Fprint(cw.w, ": keepalive\n\n")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	cw.flusher.Flush()
+	return nil
+}
+
+// serveChanges streams key changes to the client as they happen.
+//
+// Changes are sent as Server-Sent Events, or as newline-delimited JSON if
+// requested with format=ndjson or an Accept header of application/x-ndjson.
+// They may be filtered by the fingerprint, keyid, domain, event and source query
+// parameters, each of which may be repeated. Clients resume after a
+// disconnect by sending the ID of the last change seen in the Last-Event-ID
+// header or since parameter; changes since then are replayed from the
+// journal before live changes are sent. If some of those changes have been
+// pruned from the journal, a "truncated" event is sent first. Live changes
+// are read by tailing the journal, so that they are sent in the order of
+// their IDs whichever process recorded them.
+func (s *Server) serveChanges(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	var since int64
+	lastEventID := req.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = req.URL.Query().Get("since")
+	}
+	if lastEventID != "" {
+		var err error
+		since, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid event ID %q", lastEventID), http.StatusBadRequest)
+			return
+		}
+	}
+
+	cw := &changesWriter{w: w, flusher: flusher, sse: true}
+	format := req.URL.Query().Get("format")
+	if format == "ndjson" || (format == "" && strings.Contains(req.Header.Get("Accept"), contentTypeNDJSON)) {
+		cw.sse = false
+	}
+	filter := newChangesFilter(s.st, req)
+
+	// Subscribe before reading the journal so that no wakeups are missed.
+	wake := s.journal.Subscribe()
+	defer s.journal.Unsubscribe(wake)
+
+	if cw.sse {
+		w.Header().Set("Content-Type", contentTypeEventStream)
+	} else {
+		w.Header().Set("Content-Type", contentTypeNDJSON)
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	truncated := func() error {
+		return cw.write(0, changesEventTruncated, map[string]interface{}{
+			"event": changesEventTruncated,
+			"since": since,
+		})
+	}
+	if lastEventID != "" {
+		complete, err := s.journal.Complete(since)
+		if err != nil {
+			log.Errorf("failed to read journal: %v", err)
+			return
+		}
+		if !complete && truncated() != nil {
+			return
+		}
+	} else {
+		// Only changes from now on are sent.
+		var err error
+		since, err = s.journal.LastID()
+		if err != nil {
+			log.Errorf("failed to read journal: %v", err)
+			return
+		}
+	}
+
+	// The journal is tailed rather than sent the entries appended by this
+	// process, so that changes recorded by other processes sharing it, such
+	// as the load tool, are streamed too.
+	tail := s.journal.Tail(since)
+	send := func(entry *JournalEntry) error {
+		if !filter.Match(entry) {
+			return nil
+		}
+		return cw.write(entry.ID, entry.Event, entry)
+	}
+	poll := time.NewTicker(journalTailInterval)
+	defer poll.Stop()
+	lastWrite := time.Now()
+	for {
+		var writeErr error
+		wasTruncated, err := tail.Next(func(entry *JournalEntry) error {
+			writeErr = send(entry)
+			if writeErr == nil {
+				lastWrite = time.Now()
+			}
+			return writeErr
+		})
+		if writeErr != nil {
+			return
+		} else if err != nil {
+			log.Errorf("failed to read journal: %v", err)
+			return
+		}
+		if wasTruncated {
+			// This client fell behind the pruning of the journal.
+			since = tail.LastID()
+			if truncated() != nil {
+				return
+			}
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case _, ok := <-wake:
+			if !ok {
+				// The server is stopping.
+				return
+			}
+		case <-poll.C:
+			if time.Since(lastWrite) >= changesHeartbeat {
+				if cw.heartbeat() != nil {
+					return
+				}
+				lastWrite = time.Now()
+			}
+		}
+	}
+}
+
+const (
+	journalQueryDefaultLimit = 1000
+	journalQueryMaxLimit     = 10000
+)
+
+type journalQueryResult struct {
+	Entries []*JournalEntry `json:"entries"`
+
+	// Truncated is set if entries after the start of the query may have
+	// been pruned from the journal.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Next is the ID of the last entry returned, to be passed as the after
+	// parameter to continue the query, if the limit was reached.
+	Next int64 `json:"next,omitempty,string"`
+}
+
+// parseQueryTime parses a query parameter given as an RFC 3339 timestamp or
+// seconds since the Unix epoch.
+func parseQueryTime(req *http.Request, name string) (time.Time, error) {
+	value := req.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errgo.Newf("invalid %s %q", name, value)
+	}
+	return t, nil
+}
+
+func parseJournalQuery(req *http.Request) (*JournalQuery, error) {
+	q := &JournalQuery{
+		Fingerprints: queryValues(req, "fingerprint"),
+		KeyIDs:       queryValues(req, "keyid"),
+		Sources:      queryValues(req, "source"),
+		Events:       queryValues(req, "event"),
+		Limit:        journalQueryDefaultLimit,
+	}
+	var err error
+	if after := req.URL.Query().Get("after"); after != "" {
+		q.After, err = strconv.ParseInt(after, 10, 64)
+		if err != nil {
+			return nil, errgo.Newf("invalid after %q", after)
+		}
+	}
+	q.From, err = parseQueryTime(req, "from")
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	q.To, err = parseQueryTime(req, "to")
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	if limit := req.URL.Query().Get("limit"); limit != "" {
+		q.Limit, err = strconv.Atoi(limit)
+		if err != nil || q.Limit <= 0 {
+			return nil, errgo.Newf("invalid limit %q", limit)
+		}
+		if q.Limit > journalQueryMaxLimit {
+			q.Limit = journalQueryMaxLimit
+		}
+	}
+	return q, nil
+}
+
+// serveJournal responds with the journal entries matching the query
+// parameters from and to (RFC 3339 or Unix time), after (an entry ID),
+// fingerprint, keyid, source, event and limit.
+func (s *Server) serveJournal(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	q, err := parseJournalQuery(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result := &journalQueryResult{Entries: []*JournalEntry{}}
+	start := q.After
+	if !q.From.IsZero() && q.From.UnixNano()-1 > start {
+		start = q.From.UnixNano() - 1
+	}
+	complete, err := s.journal.Complete(start)
+	if err != nil {
+		log.Errorf("failed to read journal: %v", err)
+		http.Error(w, "failed to read journal", http.StatusInternalServerError)
+		return
+	}
+	result.Truncated = !complete
+	err = s.journal.Query(q, func(entry *JournalEntry) error {
+		result.Entries = append(result.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		log.Errorf("failed to read journal: %v", err)
+		http.Error(w, "failed to read journal", http.StatusInternalServerError)
+		return
+	}
+	if len(result.Entries) == q.Limit {
+		result.Next = result.Entries[len(result.Entries)-1].ID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(result)
+	if err != nil {
+		log.Errorf("failed to write journal query response: %v", err)
+	}
+}
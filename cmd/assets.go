@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"gopkg.in/errgo.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var assetsFlags struct {
+	force bool
+}
+
+var assetsCommand = &Command{
+	Name:    "assets",
+	Args:    "<dir>",
+	Summary: "Extract the embedded web UI and templates for customization with the assetsDir setting.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&assetsFlags.force, "force", false, "overwrite existing files")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 1 {
+			return usageErrorf("expected a directory")
+		}
+		written, err := server.ExtractAssets(args[0], assetsFlags.force)
+		for _, path := range written {
+			fmt.Println(path)
+		}
+		if err != nil {
+			return errgo.Notef(err, "cannot extract assets")
+		}
+		return nil
+	},
+}
@@ -4,56 +4,82 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"runtime/pprof"
-	"time"
 
 	"gopkg.in/errgo.v1"
 	log "gopkg.in/hockeypuck/logrus.v0"
+
+	"github.com/hockeypuck/server"
+)
+
+// Exit codes returned by hockeypuck commands.
+const (
+	ExitOK      = 0
+	ExitFailure = 1
+	ExitUsage   = 2
+	ExitConfig  = 3
+)
+
+var (
+	// ErrUsage is the cause of errors resulting from invalid command line
+	// arguments.
+	ErrUsage = errgo.New("usage error")
+
+	// ErrConfig is the cause of errors resulting from a missing or invalid
+	// configuration.
+	ErrConfig = errgo.New("configuration error")
 )
 
+// ExitCode returns the process exit code corresponding to err.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	switch errgo.Cause(err) {
+	case ErrUsage:
+		return ExitUsage
+	case ErrConfig:
+		return ExitConfig
+	}
+	return ExitFailure
+}
+
 func Die(err error) {
-	if err != nil {
+	code := ExitCode(err)
+	switch code {
+	case ExitOK:
+	case ExitUsage, ExitConfig:
+		fmt.Fprintln(os.Stderr, err)
+	default:
 		fmt.Fprintln(os.Stderr, errgo.Details(err))
-		os.Exit(1)
 	}
-	os.Exit(0)
+	os.Exit(code)
 }
 
-func StartCPUProf(cpuProf bool, prior *os.File) *os.File {
-	if prior != nil {
-		pprof.StopCPUProfile()
-		log.Infof("CPU profile written to %q", prior.Name())
-		prior.Close()
-		os.Rename(filepath.Join(os.TempDir(), "hockeypuck-cpu.prof.part"),
-			filepath.Join(os.TempDir(), "hockeypuck-cpu.prof"))
+// profDir is the directory to which CPU and heap profiles are written.
+var profDir = os.TempDir()
+
+// StartCPUProf stops any running CPU profile, writing it out, and starts a
+// new one if cpuProf is set.
+func StartCPUProf(cpuProf bool) {
+	if _, _, ok := server.CPUProfiling(); ok {
+		_, err := server.StopCPUProfile()
+		if err != nil {
+			log.Errorf("%v", err)
+		}
 	}
 	if cpuProf {
-		profName := filepath.Join(os.TempDir(), "hockeypuck-cpu.prof.part")
-		f, err := os.Create(profName)
+		err := server.StartCPUProfile(filepath.Join(profDir, "hockeypuck-cpu.prof"))
 		if err != nil {
 			Die(errgo.Mask(err))
 		}
-		pprof.StartCPUProfile(f)
-		return f
 	}
-	return nil
 }
 
 func WriteMemProf(memProf bool) {
 	if memProf {
-		tmpName := filepath.Join(os.TempDir(), fmt.Sprintf("hockeypuck-mem.prof.%d", time.Now().Unix()))
-		profName := filepath.Join(os.TempDir(), "hockeypuck-mem.prof")
-		f, err := os.Create(tmpName)
-		if err != nil {
-			Die(errgo.Mask(err))
-		}
-		err = pprof.WriteHeapProfile(f)
-		f.Close()
+		err := server.WriteHeapProfile(filepath.Join(profDir, "hockeypuck-mem.prof"))
 		if err != nil {
-			log.Warningf("failed to write heap profile: %v", err)
-			return
+			log.Warningf("%v", err)
 		}
-		log.Infof("Heap profile written to %q", f.Name())
-		os.Rename(tmpName, profName)
 	}
 }
@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+
+	"github.com/hockeypuck/server"
+)
+
+// Command is a hockeypuck subcommand.
+type Command struct {
+	// Name is the name used to invoke the command.
+	Name string
+
+	// Args describes the positional arguments accepted by the command.
+	Args string
+
+	// Summary is a one-line description of the command.
+	Summary string
+
+	// Strict, if true, causes the config file to be parsed strictly
+	// regardless of the -strict flag.
+	Strict bool
+
+	// SetFlags registers the command's own flags, if any.
+	SetFlags func(fs *flag.FlagSet)
+
+	// Run runs the command with the configured settings and the positional
+	// arguments remaining after flags have been parsed.
+	Run func(settings *server.Settings, args []string) error
+}
+
+var commands = map[string]*Command{}
+
+func register(c *Command) {
+	commands[c.Name] = c
+}
+
+func init() {
+	register(serveCommand)
+	register(loadCommand)
+	register(dumpCommand)
+	register(pbuildCommand)
+	register(statsCommand)
+	register(configCommand)
+	register(journalCommand)
+	register(takedownCommand)
+	register(stripCommand)
+	register(reindexCommand)
+	register(assetsCommand)
+	register(completionCommand)
+	register(helpCommand)
+}
+
+func commandNames() []string {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// globalFlags are accepted by every command, either before or after the
+// command name.
+type globalFlags struct {
+	configFile string
+	strict     bool
+	cpuProf    bool
+	memProf    bool
+	profDir    string
+	pprofAddr  string
+}
+
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.configFile, "config", "", "config file")
+	fs.BoolVar(&g.strict, "strict", false, "reject unknown keys in the config file")
+	fs.BoolVar(&g.cpuProf, "cpuprof", false, "enable CPU profiling")
+	fs.BoolVar(&g.memProf, "memprof", false, "enable mem profiling")
+	fs.StringVar(&g.profDir, "profdir", os.TempDir(), "directory to write -cpuprof and -memprof profiles to")
+	fs.StringVar(&g.pprofAddr, "pprof", "", "serve runtime profiles under /debug/pprof/ on this address while running")
+}
+
+// defaultGlobalFlags returns the global flags with their default values.
+func defaultGlobalFlags() *globalFlags {
+	var g globalFlags
+	g.register(flag.NewFlagSet("", flag.ContinueOnError))
+	return &g
+}
+
+// settings returns the settings read from the config file, if any, with any
+// overrides from the environment applied.
+func (g *globalFlags) settings() (*server.Settings, error) {
+	var conf []byte
+	if g.configFile != "" {
+		var err error
+		conf, err = ioutil.ReadFile(g.configFile)
+		if err != nil {
+			return nil, errgo.WithCausef(err, ErrConfig, "cannot read config file")
+		}
+	}
+	parse := server.ParseSettings
+	if g.strict {
+		parse = server.ParseSettingsStrict
+	}
+	settings, err := parse(string(conf))
+	if err != nil {
+		return nil, errgo.WithCausef(err, ErrConfig, "invalid config file %q", g.configFile)
+	}
+	return settings, nil
+}
+
+func usageErrorf(format string, args ...interface{}) error {
+	return errgo.WithCausef(nil, ErrUsage, format, args...)
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "usage: hockeypuck [flags] <command> [command flags] [args]\n\ncommands:\n")
+	for _, name := range commandNames() {
+		fmt.Fprintf(w, "  %-12s %s\n", name, commands[name].Summary)
+	}
+	fmt.Fprintf(w, "\nflags:\n")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+func commandUsage(w io.Writer, c *Command, fs *flag.FlagSet) {
+	synopsis := "hockeypuck " + c.Name + " [flags]"
+	if c.Args != "" {
+		synopsis += " " + c.Args
+	}
+	fmt.Fprintf(w, "usage: %s\n\n%s\n\nflags:\n", synopsis, c.Summary)
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+// Main runs the hockeypuck command named by the first non-flag argument,
+// exiting with the result. When no command is given, the server is run.
+func Main() {
+	c, g, args, err := parseMain(os.Args[1:])
+	if err != nil {
+		Die(err)
+	}
+	Die(run(c, g, args))
+}
+
+// parseMain parses the global flags given before the command name, and
+// returns the command named, or the serve command if none is, with the
+// arguments following its name.
+func parseMain(args []string) (*Command, *globalFlags, []string, error) {
+	var g globalFlags
+	fs := flag.NewFlagSet("hockeypuck", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	g.register(fs)
+	err := fs.Parse(args)
+	if err == flag.ErrHelp {
+		usage(os.Stdout, fs)
+		Die(nil)
+	} else if err != nil {
+		usage(os.Stderr, fs)
+		return nil, nil, nil, usageErrorf("%v", err)
+	}
+
+	args = fs.Args()
+	name := serveCommand.Name
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+	c, ok := commands[name]
+	if !ok {
+		usage(os.Stderr, fs)
+		return nil, nil, nil, usageErrorf("unknown command %q", name)
+	}
+	return c, &g, args, nil
+}
+
+// Run runs the named command with the given arguments, exiting with the
+// result. It is used by the single-purpose hockeypuck-* binaries.
+func Run(name string, args []string) {
+	c, ok := commands[name]
+	if !ok {
+		Die(usageErrorf("unknown command %q", name))
+	}
+	Die(run(c, defaultGlobalFlags(), args))
+}
+
+// parseCommand parses the flags of command c in args, which may be
+// interspersed with its positional arguments, and returns the flag set and
+// the positional arguments. Global flags given to the command are set on g,
+// in addition to any given before the command name.
+func parseCommand(c *Command, g *globalFlags, args []string) (*flag.FlagSet, []string, error) {
+	// The global flags are registered on the command's flag set with
+	// scratch values, as registering them with g's would reset those given
+	// before the command name to their defaults.
+	var local globalFlags
+	fs := flag.NewFlagSet(c.Name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	local.register(fs)
+	if c.SetFlags != nil {
+		c.SetFlags(fs)
+	}
+	args, err := parseInterspersed(fs, args)
+	if err != nil {
+		return fs, nil, err
+	}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "config":
+			g.configFile = local.configFile
+		case "strict":
+			g.strict = local.strict
+		case "cpuprof":
+			g.cpuProf = local.cpuProf
+		case "memprof":
+			g.memProf = local.memProf
+		case "profdir":
+			g.profDir = local.profDir
+		case "pprof":
+			g.pprofAddr = local.pprofAddr
+		}
+	})
+	return fs, args, nil
+}
+
+func run(c *Command, g *globalFlags, args []string) error {
+	fs, args, err := parseCommand(c, g, args)
+	if err == flag.ErrHelp {
+		commandUsage(os.Stdout, c, fs)
+		return nil
+	} else if err != nil {
+		commandUsage(os.Stderr, c, fs)
+		return usageErrorf("%v", err)
+	}
+
+	if c.Strict {
+		g.strict = true
+	}
+	settings, err := g.settings()
+	if err != nil {
+		return err
+	}
+
+	profDir = g.profDir
+	StartCPUProf(g.cpuProf)
+	defer StartCPUProf(false)
+
+	if g.pprofAddr != "" {
+		err := servePprof(g.pprofAddr, settings)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGUSR2)
+	defer signal.Stop(sigs)
+	go func() {
+		for range sigs {
+			StartCPUProf(g.cpuProf)
+			WriteMemProf(g.memProf)
+		}
+	}()
+
+	err = c.Run(settings, args)
+	if errgo.Cause(err) == ErrUsage {
+		commandUsage(os.Stderr, c, fs)
+	}
+	return err
+}
+
+// servePprof serves runtime profiles on addr for the lifetime of the
+// process.
+func servePprof(addr string, settings *server.Settings) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errgo.Notef(err, "cannot serve pprof")
+	}
+	if settings.Admin != nil {
+		server.SetProfileRates(settings.Admin.BlockProfileRate, settings.Admin.MutexProfileFraction)
+	}
+	log.Infof("serving pprof on http://%s/debug/pprof/", ln.Addr())
+	go func() {
+		err := http.Serve(ln, server.PprofHandler())
+		log.Errorf("pprof: %v", err)
+	}()
+	return nil
+}
+
+// parseInterspersed parses flags in args, allowing them to appear after
+// positional arguments. The positional arguments are returned.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		consumed := len(args) - len(rest)
+		if consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+var helpCommand = &Command{
+	Name:    "help",
+	Args:    "[command]",
+	Summary: "Show usage information for hockeypuck or one of its commands.",
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) == 0 {
+			var g globalFlags
+			fs := flag.NewFlagSet("hockeypuck", flag.ContinueOnError)
+			g.register(fs)
+			usage(os.Stdout, fs)
+			return nil
+		}
+		c, ok := commands[args[0]]
+		if !ok {
+			return usageErrorf("unknown command %q", args[0])
+		}
+		var g globalFlags
+		fs := flag.NewFlagSet(c.Name, flag.ContinueOnError)
+		g.register(fs)
+		if c.SetFlags != nil {
+			c.SetFlags(fs)
+		}
+		commandUsage(os.Stdout, c, fs)
+		return nil
+	},
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestGlobalFlagsBeforeAndAfterCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"before command", []string{"-config", "hp.conf", "-strict", "serve"}},
+		{"after command", []string{"serve", "-config", "hp.conf", "-strict"}},
+		{"split around command", []string{"-config", "hp.conf", "serve", "-strict"}},
+		{"default command", []string{"-config", "hp.conf", "-strict"}},
+	}
+	for _, test := range tests {
+		c, g, args, err := parseMain(test.args)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if c != serveCommand {
+			t.Fatalf("%s: got command %q, want serve", test.name, c.Name)
+		}
+		_, args, err = parseCommand(c, g, args)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(args) != 0 {
+			t.Errorf("%s: unexpected arguments %q", test.name, args)
+		}
+		if g.configFile != "hp.conf" {
+			t.Errorf("%s: got config %q, want %q", test.name, g.configFile, "hp.conf")
+		}
+		if !g.strict {
+			t.Errorf("%s: -strict not set", test.name)
+		}
+	}
+}
+
+func TestCommandFlagOverridesGlobalFlag(t *testing.T) {
+	c, g, args, err := parseMain([]string{"-config", "a.conf", "-profdir", "/prof", "serve", "-config", "b.conf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = parseCommand(c, g, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.configFile != "b.conf" {
+		t.Errorf("got config %q, want %q", g.configFile, "b.conf")
+	}
+	if g.profDir != "/prof" {
+		t.Errorf("got profdir %q, want %q", g.profDir, "/prof")
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hockeypuck/server"
+)
+
+var completionCommand = &Command{
+	Name:    "completion",
+	Args:    "bash|zsh",
+	Summary: "Print a shell completion script for hockeypuck.",
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 1 {
+			return usageErrorf("expected shell name")
+		}
+		switch args[0] {
+		case "bash":
+			writeBashCompletion(os.Stdout)
+		case "zsh":
+			fmt.Fprintln(os.Stdout, "autoload -U +X bashcompinit && bashcompinit")
+			writeBashCompletion(os.Stdout)
+		default:
+			return usageErrorf("unsupported shell %q", args[0])
+		}
+		return nil
+	},
+}
+
+// flagNames returns the flags accepted by command c, including the global
+// flags, formatted as they would be typed on the command line.
+func flagNames(c *Command) string {
+	var g globalFlags
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	g.register(fs)
+	if c != nil && c.SetFlags != nil {
+		c.SetFlags(fs)
+	}
+	var names []string
+	fs.VisitAll(func(f *flag.Flag) {
+		names = append(names, "-"+f.Name)
+	})
+	return strings.Join(names, " ")
+}
+
+func writeBashCompletion(w io.Writer) {
+	var cases bytes.Buffer
+	for _, name := range commandNames() {
+		fmt.Fprintf(&cases, "\t%s)\n\t\tCOMPREPLY=( $(compgen -W %q -f -- \"$cur\") )\n\t\t;;\n",
+			name, flagNames(commands[name]))
+	}
+	fmt.Fprintf(w, `_hockeypuck() {
+	local cur prev cmd i
+	cur="${COMP_WORDS[COMP_CWORD]}"
+	prev="${COMP_WORDS[COMP_CWORD-1]}"
+	case "$prev" in
+	-config|-path)
+		COMPREPLY=( $(compgen -f -- "$cur") )
+		return
+		;;
+	esac
+	for ((i=1; i < COMP_CWORD; i++)); do
+		case "${COMP_WORDS[i]}" in
+		-config) ((i++)) ;;
+		-*) ;;
+		*) cmd="${COMP_WORDS[i]}"; break ;;
+		esac
+	done
+	case "$cmd" in
+	"")
+		COMPREPLY=( $(compgen -W %q -- "$cur") )
+		;;
+%s	esac
+}
+complete -F _hockeypuck hockeypuck
+`, strings.Join(commandNames(), " ")+" "+flagNames(nil), cases.String())
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/errgo.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var configCommand = &Command{
+	Name:    "config",
+	Args:    "check",
+	Summary: "Check the config file for unknown keys and invalid settings, and print the effective configuration.",
+	Strict:  true,
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 1 || args[0] != "check" {
+			return usageErrorf("expected config subcommand \"check\"")
+		}
+		return configCheck(settings)
+	},
+}
+
+const redacted = "<redacted>"
+
+func configCheck(settings *server.Settings) error {
+	err := settings.Validate()
+	if err != nil {
+		return errgo.WithCausef(err, ErrConfig, "config check failed")
+	}
+
+	// Avoid printing secrets.
+	effective := *settings
+	if effective.OpenPGP.PKS != nil && effective.OpenPGP.PKS.SMTP.Password != "" {
+		pks := *effective.OpenPGP.PKS
+		pks.SMTP.Password = redacted
+		effective.OpenPGP.PKS = &pks
+	}
+	if effective.OpenPGP.Verification != nil && effective.OpenPGP.Verification.SMTP.Password != "" {
+		verification := *effective.OpenPGP.Verification
+		verification.SMTP.Password = redacted
+		effective.OpenPGP.Verification = &verification
+	}
+	// DSNs may hold database credentials however they were configured.
+	if effective.OpenPGP.DB.DSN != "" {
+		effective.OpenPGP.DB.DSN = redacted
+	}
+	if effective.Admin != nil && effective.Admin.Token != "" {
+		admin := *effective.Admin
+		admin.Token = redacted
+		effective.Admin = &admin
+	}
+	if effective.Webhooks != nil {
+		webhooks := *effective.Webhooks
+		webhooks.Hooks = make(map[string]server.WebhookConfig)
+		for name, hook := range effective.Webhooks.Hooks {
+			if hook.Secret != "" {
+				hook.Secret = redacted
+			}
+			webhooks.Hooks[name] = hook
+		}
+		effective.Webhooks = &webhooks
+	}
+
+	var doc struct {
+		Hockeypuck *server.Settings `toml:"hockeypuck"`
+	}
+	doc.Hockeypuck = &effective
+	fmt.Fprintln(os.Stdout, "# effective configuration")
+	return errgo.Mask(toml.NewEncoder(os.Stdout).Encode(&doc))
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+
+	"github.com/hockeypuck/server"
+)
+
+var dumpFlags struct {
+	outputDir string
+	count     int
+}
+
+var dumpCommand = &Command{
+	Name:    "dump",
+	Summary: "Dump all keys in the prefix tree to files.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.StringVar(&dumpFlags.outputDir, "path", ".", "output path")
+		fs.IntVar(&dumpFlags.count, "count", 15000, "keys per file")
+	},
+	Run: dump,
+}
+
+func dump(settings *server.Settings, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("unexpected command line arguments")
+	}
+
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer ptree.Close()
+
+	var t tomb.Tomb
+	ch := make(chan string)
+
+	t.Go(func() error {
+		var i int
+		var digests []string
+		defer func() {
+			for _ = range ch {
+			}
+		}() // drain if early return on error
+		for digest := range ch {
+			digests = append(digests, digest)
+			if len(digests) >= dumpFlags.count {
+				err := writeKeys(st, digests, i)
+				if err != nil {
+					return errgo.Mask(err)
+				}
+				i++
+				digests = nil
+			}
+		}
+		if len(digests) > 0 {
+			err := writeKeys(st, digests, i)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		return nil
+	})
+	t.Go(func() error {
+		defer close(ch)
+		return WalkDigests(ptree, func(digest string) error {
+			ch <- digest
+			return nil
+		})
+	})
+	return t.Wait()
+}
+
+const chunksize = 20
+
+func writeKeys(st storage.Queryer, digests []string, num int) error {
+	rfps, err := st.MatchMD5(digests)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("matched %d fingerprints", len(rfps))
+	f, err := os.Create(filepath.Join(dumpFlags.outputDir, fmt.Sprintf("hkp-dump-%04d.pgp", num)))
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer f.Close()
+
+	for len(rfps) > 0 {
+		var chunk []string
+		if len(rfps) > chunksize {
+			chunk = rfps[:chunksize]
+			rfps = rfps[chunksize:]
+		} else {
+			chunk = rfps
+			rfps = nil
+		}
+
+		keys, err := st.FetchKeys(chunk)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		for _, key := range keys {
+			err := openpgp.WritePackets(f, key)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+	}
+	return nil
+}
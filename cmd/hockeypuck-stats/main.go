@@ -0,0 +1,12 @@
+// Command hockeypuck-stats is equivalent to "hockeypuck stats".
+package main
+
+import (
+	"os"
+
+	"github.com/hockeypuck/server/cmd"
+)
+
+func main() {
+	cmd.Run("stats", os.Args[1:])
+}
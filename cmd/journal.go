@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var journalFlags struct {
+	from, to     string
+	after        int64
+	fingerprints string
+	keyIDs       string
+	sources      string
+	events       string
+	target       string
+	batch        int
+	dryRun       bool
+}
+
+var journalCommand = &Command{
+	Name:    "journal",
+	Args:    "query|replay",
+	Summary: "Query the change journal, or replay the keys changed in a range into another server.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.StringVar(&journalFlags.from, "from", "", "select changes at or after this time (RFC 3339)")
+		fs.StringVar(&journalFlags.to, "to", "", "select changes before this time (RFC 3339)")
+		fs.Int64Var(&journalFlags.after, "after", 0, "select changes after this journal entry ID")
+		fs.StringVar(&journalFlags.fingerprints, "fingerprint", "", "select changes to keys with these comma-separated fingerprints")
+		fs.StringVar(&journalFlags.keyIDs, "keyid", "", "select changes to keys with these comma-separated key IDs")
+		fs.StringVar(&journalFlags.sources, "source", "", "select changes from these comma-separated sources: hkp, recon, pks, load, admin, vks")
+		fs.StringVar(&journalFlags.events, "event", "", "select these comma-separated events: added, replaced, deleted")
+		fs.StringVar(&journalFlags.target, "target", "", "replay: base URL of the server to submit keys to, such as http://host:11371")
+		fs.IntVar(&journalFlags.batch, "batch", 100, "replay: number of keys submitted per request")
+		fs.BoolVar(&journalFlags.dryRun, "dry-run", false, "replay: report the keys that would be submitted without submitting them")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 1 {
+			return usageErrorf("expected journal subcommand \"query\" or \"replay\"")
+		}
+		if settings.Journal == nil {
+			return errgo.WithCausef(nil, ErrConfig, "no journal configured")
+		}
+		q, err := journalQuery()
+		if err != nil {
+			return errgo.Mask(err, errgo.Is(ErrUsage))
+		}
+		switch args[0] {
+		case "query":
+			return journalQueryCmd(settings, q)
+		case "replay":
+			if journalFlags.target == "" && !journalFlags.dryRun {
+				return usageErrorf("replay requires -target")
+			}
+			if journalFlags.batch <= 0 {
+				return usageErrorf("invalid -batch %d", journalFlags.batch)
+			}
+			return journalReplay(settings, q)
+		}
+		return usageErrorf("unknown journal subcommand %q", args[0])
+	},
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func journalQuery() (*server.JournalQuery, error) {
+	q := &server.JournalQuery{
+		After:        journalFlags.after,
+		Fingerprints: splitList(journalFlags.fingerprints),
+		KeyIDs:       splitList(journalFlags.keyIDs),
+		Sources:      splitList(journalFlags.sources),
+		Events:       splitList(journalFlags.events),
+	}
+	var err error
+	if journalFlags.from != "" {
+		q.From, err = time.Parse(time.RFC3339, journalFlags.from)
+		if err != nil {
+			return nil, usageErrorf("invalid -from: %v", err)
+		}
+	}
+	if journalFlags.to != "" {
+		q.To, err = time.Parse(time.RFC3339, journalFlags.to)
+		if err != nil {
+			return nil, usageErrorf("invalid -to: %v", err)
+		}
+	}
+	return q, nil
+}
+
+// journalQueryCmd prints the matching journal entries as newline-delimited
+// JSON.
+func journalQueryCmd(settings *server.Settings, q *server.JournalQuery) error {
+	j, err := server.OpenJournal(settings.Journal)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer j.Close()
+
+	enc := json.NewEncoder(os.Stdout)
+	return errgo.Mask(j.Query(q, func(entry *server.JournalEntry) error {
+		return enc.Encode(entry)
+	}))
+}
+
+// journalReplay submits the current version of each key changed within the
+// selected range to the target server. Storage holds only the latest version
+// of each key, so a key changed several times is submitted once, as it is
+// now.
+func journalReplay(settings *server.Settings, q *server.JournalQuery) error {
+	j, err := server.OpenJournal(settings.Journal)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer j.Close()
+
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	var rfps []string
+	seen := make(map[string]bool)
+	err = j.Query(q, func(entry *server.JournalEntry) error {
+		if entry.NewDigest == "" {
+			// Deleted keys are not replayed.
+			return nil
+		}
+		rfp := server.ReverseString(strings.ToLower(entry.Fingerprint))
+		if rfp == "" {
+			matches, err := st.MatchMD5([]string{entry.NewDigest})
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			if len(matches) == 0 {
+				log.Warningf("key %q in journal entry %d not found", entry.NewDigest, entry.ID)
+				return nil
+			}
+			rfp = matches[0]
+		}
+		if !seen[rfp] {
+			seen[rfp] = true
+			rfps = append(rfps, rfp)
+		}
+		return nil
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	var submitted int
+	for len(rfps) > 0 {
+		n := journalFlags.batch
+		if n > len(rfps) {
+			n = len(rfps)
+		}
+		batch := rfps[:n]
+		rfps = rfps[n:]
+
+		keys, err := st.FetchKeys(batch)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		if len(keys) < len(batch) {
+			log.Warningf("%d keys no longer in storage", len(batch)-len(keys))
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if journalFlags.dryRun {
+			for _, key := range keys {
+				fmt.Fprintln(os.Stdout, key.Fingerprint())
+			}
+			continue
+		}
+		err = submitKeys(journalFlags.target, keys)
+		if err != nil {
+			return errgo.Notef(err, "failed to submit keys after %d submitted", submitted)
+		}
+		submitted += len(keys)
+		log.Infof("submitted %d keys", submitted)
+	}
+	return nil
+}
+
+// submitKeys posts keys to the /pks/add endpoint of an HKP server.
+func submitKeys(target string, keys []*openpgp.PrimaryKey) error {
+	var armor bytes.Buffer
+	err := openpgp.WriteArmoredPackets(&armor, keys)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	resp, err := http.PostForm(strings.TrimSuffix(target, "/")+"/pks/add", url.Values{
+		"keytext": {armor.String()},
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errgo.Newf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// journalKeyChanges records changes made by the tool to st in the journal,
+// if one is configured. The returned function closes the journal.
+func journalKeyChanges(settings *server.Settings, st storage.Storage, source string) (func(), error) {
+	if settings.Journal == nil {
+		return func() {}, nil
+	}
+	j, err := server.OpenJournal(settings.Journal)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	j.Record(st, func(string) string { return source })
+	return func() {
+		err := j.Close()
+		if err != nil {
+			log.Errorf("failed to close journal: %v", err)
+		}
+	}, nil
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var loadCommand = &Command{
+	Name:    "load",
+	Args:    "<file1> [file2 .. fileN]",
+	Summary: "Load keys from files into storage and the prefix tree.",
+	Run:     load,
+}
+
+func load(settings *server.Settings, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("missing PGP key file arguments")
+	}
+
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	closeJournal, err := journalKeyChanges(settings, st, server.SourceLoad)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer closeJournal()
+
+	ingestion, err := server.OpenIngestion(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	// Deleted keys and keys rejected by policy are not loaded.
+	ingest := ingestion.Storage(st, server.SourceLoad)
+
+	ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer ptree.Close()
+
+	statsFilename := sks.StatsFilename(settings.Conflux.Recon.LevelDB.Path)
+	stats := sks.NewStats()
+	err = stats.ReadFile(statsFilename)
+	if err != nil {
+		log.Warningf("failed to open stats file %q: %v", statsFilename, err)
+		stats = sks.NewStats()
+	}
+	defer stats.WriteFile(statsFilename)
+
+	st.Subscribe(func(kc storage.KeyChange) error {
+		stats.Update(kc)
+		ka, ok := kc.(storage.KeyAdded)
+		if ok {
+			digestZp, err := sks.DigestZp(ka.Digest)
+			if err != nil {
+				return errgo.Notef(err, "bad digest %q", ka.Digest)
+			}
+			return ptree.Insert(digestZp)
+		}
+		return nil
+	})
+
+	for _, arg := range args {
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			log.Errorf("failed to match %q: %v", arg, err)
+			continue
+		}
+		for _, file := range matches {
+			f, err := os.Open(file)
+			if err != nil {
+				log.Errorf("failed to open %q for reading: %v", file, err)
+				continue
+			}
+			var keys []*openpgp.PrimaryKey
+			for kr := range openpgp.ReadKeys(f) {
+				if kr.Error != nil {
+					log.Errorf("error reading key: %v", errgo.Details(kr.Error))
+				} else {
+					keys = append(keys, kr.PrimaryKey)
+				}
+			}
+			f.Close()
+			t := time.Now()
+			n, err := ingest.Insert(keys)
+			if err != nil {
+				log.Errorf("some keys failed to insert from %q: %v", file, errgo.Details(err))
+			}
+			if n > 0 {
+				log.Infof("inserted %d keys from %q in %v", n, file, time.Since(t))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/conflux.v2/recon"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+
+	"github.com/hockeypuck/server"
+)
+
+var pbuildFlags struct {
+	incremental bool
+	resume      bool
+}
+
+var pbuildCommand = &Command{
+	Name:    "pbuild",
+	Summary: "Rebuild the prefix tree from the keys in storage.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&pbuildFlags.incremental, "incremental", false, "only add digests not already present in the existing prefix tree")
+		fs.BoolVar(&pbuildFlags.resume, "resume", false, "resume an interrupted rebuild from its last checkpoint")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 0 {
+			return usageErrorf("unexpected command line arguments")
+		}
+		if pbuildFlags.incremental && pbuildFlags.resume {
+			return usageErrorf("-incremental and -resume cannot be used together")
+		}
+		if pbuildFlags.incremental {
+			return pbuildIncremental(settings)
+		}
+		return pbuild(settings, pbuildFlags.resume)
+	},
+}
+
+// checkpointInterval is the number of keys added between checkpoints.
+const checkpointInterval = 5000
+
+// checkpoint records the progress of a rebuild in progress, so that it may be
+// resumed if interrupted.
+type checkpoint struct {
+	Started time.Time `json:"started"`
+	Updated time.Time `json:"updated"`
+	Added   int       `json:"added"`
+}
+
+// checkpointFilename returns the name of the checkpoint of the rebuild of the
+// prefix tree at path. It is kept alongside the LevelDB directory rather than
+// within it, so that it is not moved into place with the rebuilt tree.
+func checkpointFilename(path string) string {
+	return path + ".checkpoint"
+}
+
+func readCheckpoint(path string) (*checkpoint, error) {
+	buf, err := ioutil.ReadFile(checkpointFilename(path))
+	if err != nil {
+		return nil, errgo.Mask(err, os.IsNotExist)
+	}
+	var cp checkpoint
+	err = json.Unmarshal(buf, &cp)
+	if err != nil {
+		return nil, errgo.Notef(err, "invalid checkpoint in %q", path)
+	}
+	return &cp, nil
+}
+
+func writeCheckpoint(path string, cp *checkpoint) error {
+	cp.Updated = time.Now()
+	buf, err := json.Marshal(cp)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	filename := checkpointFilename(path)
+	err = ioutil.WriteFile(filename+".part", buf, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(os.Rename(filename+".part", filename))
+}
+
+// progress logs the rate at which keys are added to the prefix tree.
+type progress struct {
+	start time.Time
+	seen  int
+	added int
+}
+
+func newProgress() *progress {
+	return &progress{start: time.Now()}
+}
+
+func (p *progress) rate() float64 {
+	elapsed := time.Since(p.start).Seconds()
+	if elapsed == 0 {
+		return 0
+	}
+	return float64(p.seen) / elapsed
+}
+
+func (p *progress) log() {
+	log.Infof("%d keys seen, %d digests added in %v (%.1f keys/s)",
+		p.seen, p.added, time.Since(p.start), p.rate())
+}
+
+// pbuild rebuilds the prefix tree from scratch. The tree is built in a
+// temporary directory alongside the configured LevelDB path, which replaces
+// the existing tree only once the build has completed successfully.
+func pbuild(settings *server.Settings, resume bool) error {
+	path := settings.Conflux.Recon.LevelDB.Path
+	tmpPath := path + ".pbuild"
+
+	cp, err := readCheckpoint(tmpPath)
+	if os.IsNotExist(errgo.Cause(err)) {
+		cp = nil
+	} else if err != nil {
+		return errgo.Mask(err)
+	}
+	if resume {
+		if cp == nil {
+			return errgo.Newf("no checkpoint %q found, cannot resume", checkpointFilename(tmpPath))
+		}
+		log.Infof("resuming rebuild started %v, %d digests already added", cp.Started, cp.Added)
+	} else {
+		if cp != nil {
+			log.Warningf("discarding interrupted rebuild in %q; use -resume to continue it instead", tmpPath)
+		}
+		err = os.RemoveAll(tmpPath)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		err = os.Remove(checkpointFilename(tmpPath))
+		if err != nil && !os.IsNotExist(err) {
+			return errgo.Mask(err)
+		}
+		cp = &checkpoint{Started: time.Now()}
+	}
+
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	ptree, err := sks.NewPrefixTree(tmpPath, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = writeCheckpoint(tmpPath, cp)
+	if err != nil {
+		ptree.Close()
+		return errgo.Mask(err)
+	}
+
+	// When resuming, digests already inserted into the partial tree are
+	// skipped.
+	var present map[string]bool
+	if resume {
+		present, err = treeDigests(ptree)
+		if err != nil {
+			ptree.Close()
+			return errgo.Mask(err)
+		}
+	}
+
+	statsFilename := sks.StatsFilename(tmpPath)
+	stats := sks.NewStats()
+	if resume {
+		err = stats.ReadFile(statsFilename)
+		if err != nil {
+			log.Warningf("failed to read stats file %q: %v", statsFilename, err)
+			stats = sks.NewStats()
+		}
+	}
+
+	p := newProgress()
+	st.Subscribe(func(kc storage.KeyChange) error {
+		ka, ok := kc.(storage.KeyAdded)
+		if !ok {
+			return nil
+		}
+		p.seen++
+		if present[ka.Digest] {
+			return nil
+		}
+		err := insertDigest(ptree, ka.Digest)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		stats.Update(kc)
+		p.added++
+		cp.Added++
+
+		if p.seen%checkpointInterval == 0 {
+			p.log()
+			err = stats.WriteFile(statsFilename)
+			if err != nil {
+				log.Warningf("error writing stats: %v", err)
+			}
+			return errgo.Mask(writeCheckpoint(tmpPath, cp))
+		}
+		return nil
+	})
+
+	err = st.RenotifyAll()
+	if err != nil {
+		ptree.Close()
+		if cpErr := writeCheckpoint(tmpPath, cp); cpErr != nil {
+			log.Errorf("failed to write checkpoint: %v", cpErr)
+		}
+		return errgo.Notef(err, "rebuild interrupted after %d digests; use -resume to continue", cp.Added)
+	}
+	n, err := insertTombstoneDigests(settings, ptree, present)
+	if err != nil {
+		ptree.Close()
+		return errgo.Mask(err)
+	}
+	log.Infof("%d digests of deleted keys kept in prefix tree", n)
+	err = stats.WriteFile(statsFilename)
+	if err != nil {
+		log.Warningf("error writing stats: %v", err)
+	}
+	err = ptree.Close()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	p.log()
+
+	err = swapTree(tmpPath, path)
+	if err != nil {
+		return errgo.Notef(err, "failed to replace %q with rebuilt prefix tree %q", path, tmpPath)
+	}
+	// The checkpoint is kept until the rebuilt tree is in place, so that a
+	// failed swap may be retried with -resume.
+	err = os.Remove(checkpointFilename(tmpPath))
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("prefix tree %q rebuilt in %v", path, time.Since(cp.Started))
+	return nil
+}
+
+// swapTree moves the prefix tree at newPath, along with its stats file, into
+// place at path. The tree previously at path is removed.
+//
+// The old tree is moved aside to path+".old" before the new tree is moved
+// into place, so there is briefly no tree at path. If the new tree cannot be
+// moved into place, the old one is moved back; if that also fails, the
+// returned error says where the old tree was left, and both trees remain for
+// the operator to put one back at path.
+func swapTree(newPath, path string) error {
+	oldPath := path + ".old"
+	err := os.RemoveAll(oldPath)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = os.Rename(path, oldPath)
+	if err != nil && !os.IsNotExist(err) {
+		return errgo.Mask(err)
+	}
+	err = os.Rename(newPath, path)
+	if err != nil {
+		// Put the old tree back where we found it.
+		if rbErr := os.Rename(oldPath, path); rbErr != nil && !os.IsNotExist(rbErr) {
+			return errgo.Notef(err, "cannot restore the previous prefix tree from %q (%v)", oldPath, rbErr)
+		}
+		return errgo.Mask(err)
+	}
+
+	// The stats file may live outside of the LevelDB directory, in which
+	// case it needs to be moved separately.
+	newStats, stats := sks.StatsFilename(newPath), sks.StatsFilename(path)
+	if !strings.HasPrefix(newStats, newPath+string(filepath.Separator)) {
+		err = os.Rename(newStats, stats)
+		if err != nil && !os.IsNotExist(err) {
+			return errgo.Mask(err)
+		}
+	}
+	return errgo.Mask(os.RemoveAll(oldPath))
+}
+
+// pbuildIncremental adds digests to the existing prefix tree for any keys in
+// storage that are not already present.
+func pbuildIncremental(settings *server.Settings) error {
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer ptree.Close()
+
+	present, err := treeDigests(ptree)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("%d digests already present in prefix tree", len(present))
+
+	statsFilename := sks.StatsFilename(settings.Conflux.Recon.LevelDB.Path)
+	stats := sks.NewStats()
+	err = stats.ReadFile(statsFilename)
+	if err != nil {
+		log.Warningf("failed to read stats file %q: %v", statsFilename, err)
+		stats = sks.NewStats()
+	}
+	defer func() {
+		err := stats.WriteFile(statsFilename)
+		if err != nil {
+			log.Warningf("error writing stats: %v", err)
+		}
+	}()
+
+	p := newProgress()
+	defer p.log()
+	st.Subscribe(func(kc storage.KeyChange) error {
+		ka, ok := kc.(storage.KeyAdded)
+		if !ok {
+			return nil
+		}
+		p.seen++
+		if p.seen%checkpointInterval == 0 {
+			p.log()
+		}
+		if present[ka.Digest] {
+			return nil
+		}
+		err := insertDigest(ptree, ka.Digest)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		stats.Update(kc)
+		p.added++
+		return nil
+	})
+
+	err = st.RenotifyAll()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	n, err := insertTombstoneDigests(settings, ptree, present)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("%d digests of deleted keys kept in prefix tree", n)
+	return nil
+}
+
+// insertTombstoneDigests adds the digests of deleted keys which are not
+// already present to the prefix tree, as they are kept there while the keys
+// are deleted; see server.KeyDeleted. It returns the number added.
+func insertTombstoneDigests(settings *server.Settings, ptree recon.PrefixTree, present map[string]bool) (int, error) {
+	tombstones, err := server.OpenTombstones(&settings.OpenPGP.Tombstones)
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	var n int
+	for _, ts := range tombstones.List() {
+		if ts.Digest == "" || present[ts.Digest] {
+			continue
+		}
+		err := insertDigest(ptree, ts.Digest)
+		if err != nil {
+			return n, errgo.Mask(err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func insertDigest(ptree recon.PrefixTree, digest string) error {
+	digestZp, err := sks.DigestZp(digest)
+	if err != nil {
+		return errgo.Notef(err, "bad digest %q", digest)
+	}
+	err = ptree.Insert(digestZp)
+	if err != nil {
+		return errgo.Notef(err, "failed to insert digest %q", digest)
+	}
+	return nil
+}
+
+// treeDigests returns the set of all digests stored in the prefix tree.
+func treeDigests(ptree recon.PrefixTree) (map[string]bool, error) {
+	digests := make(map[string]bool)
+	err := WalkDigests(ptree, func(digest string) error {
+		digests[digest] = true
+		return nil
+	})
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return digests, nil
+}
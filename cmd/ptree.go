@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/conflux.v2/recon"
+)
+
+// WalkDigests calls f with every digest stored in the prefix tree, in
+// breadth-first order.
+func WalkDigests(ptree recon.PrefixTree, f func(digest string) error) error {
+	root, err := ptree.Root()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	nodes := []recon.PrefixNode{root}
+	for len(nodes) > 0 {
+		node := nodes[0]
+		nodes = nodes[1:]
+
+		if node.IsLeaf() {
+			elements, err := node.Elements()
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			for _, element := range elements {
+				zb := element.Bytes()
+				err = f(strings.ToLower(hex.EncodeToString(zb)))
+				if err != nil {
+					return errgo.Mask(err, errgo.Any)
+				}
+			}
+		} else {
+			children, err := node.Children()
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			nodes = append(nodes, children...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"gopkg.in/errgo.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var reindexCommand = &Command{
+	Name:    "reindex",
+	Summary: "Rebuild the search index from the keys in storage.",
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 0 {
+			return usageErrorf("unexpected command line arguments")
+		}
+		return reindex(settings)
+	},
+}
+
+// reindex rebuilds the search index, which requires the server to be
+// stopped, as it saves its own index when it stops.
+func reindex(settings *server.Settings) error {
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+	return errgo.Mask(server.RebuildSearchIndex(settings, st))
+}
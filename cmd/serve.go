@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"gopkg.in/errgo.v1"
+
+	"github.com/hockeypuck/server"
+)
+
+var serveCommand = &Command{
+	Name:    "serve",
+	Summary: "Run the keyserver. This is the default command.",
+	Run:     serve,
+}
+
+func serve(settings *server.Settings, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("unexpected command line arguments")
+	}
+
+	// Settings are checked as by "config check", so that the server does not
+	// start with a configuration which it cannot serve safely.
+	err := settings.Validate()
+	if err != nil {
+		return errgo.WithCausef(err, ErrConfig, "invalid config")
+	}
+
+	srv, err := server.NewServer(settings)
+	if err != nil {
+		return err
+	}
+
+	srv.Start()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR1)
+	go func() {
+		for sig := range c {
+			switch sig {
+			case syscall.SIGINT, syscall.SIGTERM:
+				srv.Stop()
+			case syscall.SIGUSR1:
+				srv.LogRotate()
+			}
+		}
+	}()
+
+	return srv.Wait()
+}
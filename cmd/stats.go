@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+
+	"github.com/hockeypuck/server"
+)
+
+var statsFlags struct {
+	report bool
+	format string
+	window time.Duration
+}
+
+var statsCommand = &Command{
+	Name:    "stats",
+	Summary: "Regenerate the stats file from storage, or report its contents.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&statsFlags.report, "report", false, "print the current stats file instead of regenerating it")
+		fs.StringVar(&statsFlags.format, "format", "json", "report output format: json or csv")
+		fs.DurationVar(&statsFlags.window, "window", 7*24*time.Hour, "regenerate load statistics for keys created or modified within this window")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) != 0 {
+			return usageErrorf("unexpected command line arguments")
+		}
+		if statsFlags.report {
+			return printReport(settings, os.Stdout, statsFlags.format)
+		}
+		return regenerate(settings, statsFlags.window)
+	},
+}
+
+const matchSize = 15000
+
+// regenerate rebuilds the stats file from the prefix tree and the creation
+// and modification times of keys in storage. Keys created within the window
+// count as inserted at their creation time; keys modified since they were
+// created count as updated at their last modification time.
+func regenerate(settings *server.Settings, window time.Duration) error {
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer ptree.Close()
+
+	since := time.Now().Add(-window)
+	stats := sks.NewStats()
+
+	var digests []string
+	flush := func() error {
+		err := updateStats(st, stats, digests, since)
+		digests = nil
+		return errgo.Mask(err)
+	}
+	err = WalkDigests(ptree, func(digest string) error {
+		stats.Total++
+		digests = append(digests, digest)
+		if len(digests) >= matchSize {
+			log.Infof("%d digests scanned", stats.Total)
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = flush()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	statsFilename := sks.StatsFilename(settings.Conflux.Recon.LevelDB.Path)
+	err = stats.WriteFile(statsFilename)
+	if err != nil {
+		return errgo.Notef(err, "failed to write stats file %q", statsFilename)
+	}
+	log.Infof("regenerated %q from %d keys", statsFilename, stats.Total)
+	return nil
+}
+
+func updateStats(st storage.Queryer, stats *sks.Stats, digests []string, since time.Time) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	rfps, err := st.MatchMD5(digests)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for len(rfps) > 0 {
+		var chunk []string
+		if len(rfps) > chunksize {
+			chunk = rfps[:chunksize]
+			rfps = rfps[chunksize:]
+		} else {
+			chunk = rfps
+			rfps = nil
+		}
+
+		keyrings, err := st.FetchKeyrings(chunk)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		for _, kr := range keyrings {
+			if kr.CTime.After(since) {
+				loadStatAt(stats.Hourly, kr.CTime, time.Hour).Inserted++
+				loadStatAt(stats.Daily, kr.CTime, 24*time.Hour).Inserted++
+			}
+			if kr.MTime.After(since) && kr.MTime.After(kr.CTime) {
+				loadStatAt(stats.Hourly, kr.MTime, time.Hour).Updated++
+				loadStatAt(stats.Daily, kr.MTime, 24*time.Hour).Updated++
+			}
+		}
+	}
+	return nil
+}
+
+func loadStatAt(m sks.LoadStatMap, t time.Time, period time.Duration) *sks.LoadStat {
+	k := t.UTC().Truncate(period)
+	ls, ok := m[k]
+	if !ok {
+		ls = &sks.LoadStat{}
+		m[k] = ls
+	}
+	return ls
+}
+
+type reportStat struct {
+	Time     time.Time `json:"time"`
+	Inserted int       `json:"inserted"`
+	Updated  int       `json:"updated"`
+}
+
+type reportStats []reportStat
+
+func (s reportStats) Len() int           { return len(s) }
+func (s reportStats) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s reportStats) Less(i, j int) bool { return s[i].Time.Before(s[j].Time) }
+
+func newReportStats(m sks.LoadStatMap) reportStats {
+	var result reportStats
+	for k, v := range m {
+		result = append(result, reportStat{Time: k, Inserted: v.Inserted, Updated: v.Updated})
+	}
+	sort.Sort(result)
+	return result
+}
+
+// printReport writes the totals and histograms in the stats file to w,
+// without requiring a storage connection.
+func printReport(settings *server.Settings, w io.Writer, format string) error {
+	statsFilename := sks.StatsFilename(settings.Conflux.Recon.LevelDB.Path)
+	stats := sks.NewStats()
+	err := stats.ReadFile(statsFilename)
+	if err != nil {
+		return errgo.Notef(err, "failed to read stats file %q", statsFilename)
+	}
+
+	hourly, daily := newReportStats(stats.Hourly), newReportStats(stats.Daily)
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return errgo.Mask(enc.Encode(struct {
+			Total  int         `json:"total"`
+			Hourly reportStats `json:"hourly"`
+			Daily  reportStats `json:"daily"`
+		}{stats.Total, hourly, daily}))
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"period", "time", "inserted", "updated"})
+		cw.Write([]string{"total", "", strconv.Itoa(stats.Total), ""})
+		for _, period := range []struct {
+			name  string
+			stats reportStats
+		}{{"hourly", hourly}, {"daily", daily}} {
+			for _, s := range period.stats {
+				cw.Write([]string{period.name, s.Time.Format(time.RFC3339),
+					strconv.Itoa(s.Inserted), strconv.Itoa(s.Updated)})
+			}
+		}
+		cw.Flush()
+		return errgo.Mask(cw.Error())
+	}
+	return usageErrorf("unsupported report format %q", format)
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+
+	"github.com/hockeypuck/server"
+)
+
+var stripFlags struct {
+	dryRun bool
+}
+
+var stripCommand = &Command{
+	Name:    "strip",
+	Summary: "Rewrite the keys in storage without their third-party certifications.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.BoolVar(&stripFlags.dryRun, "dry-run", false, "count the keys which would be rewritten, without changing them")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) > 0 {
+			return usageErrorf("unexpected command line arguments")
+		}
+		return strip(settings)
+	},
+}
+
+// strip rewrites the keys in storage, updating the prefix tree directly,
+// which requires the server to be stopped; the admin API may be used to
+// strip keys while it is running.
+func strip(settings *server.Settings) error {
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	backend, err := server.DialKeyBackend(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer backend.Close()
+
+	stripper := server.NewStripper(st, backend)
+	defer stripper.Close()
+
+	if !stripFlags.dryRun {
+		closeJournal, err := journalKeyChanges(settings, st, server.SourceAdmin)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		defer closeJournal()
+
+		ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		err = ptree.Create()
+		if err != nil {
+			return errgo.Notef(err, "cannot open prefix tree; use the admin API while the server is running")
+		}
+		defer ptree.Close()
+
+		st.Subscribe(func(kc storage.KeyChange) error {
+			for _, digest := range kc.RemoveDigests() {
+				digestZp, err := sks.DigestZp(digest)
+				if err != nil {
+					return errgo.Notef(err, "bad digest %q", digest)
+				}
+				err = ptree.Remove(digestZp)
+				if err != nil {
+					return errgo.Mask(err)
+				}
+			}
+			for _, digest := range kc.InsertDigests() {
+				digestZp, err := sks.DigestZp(digest)
+				if err != nil {
+					return errgo.Notef(err, "bad digest %q", digest)
+				}
+				err = ptree.Insert(digestZp)
+				if err != nil {
+					return errgo.Mask(err)
+				}
+			}
+			return nil
+		})
+	}
+
+	err = stripper.Run(stripFlags.dryRun)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	status := stripper.Status()
+	return errgo.Mask(json.NewEncoder(os.Stdout).Encode(&status))
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"os/user"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+
+	"github.com/hockeypuck/server"
+)
+
+var takedownFlags struct {
+	actor  string
+	reason string
+}
+
+var takedownCommand = &Command{
+	Name:    "takedown",
+	Args:    "delete|restore <fingerprint>... | list | audit",
+	Summary: "Delete keys and prevent them from being accepted again, or list and restore deleted keys.",
+	SetFlags: func(fs *flag.FlagSet) {
+		fs.StringVar(&takedownFlags.actor, "actor", "", "who the change is made by, for the audit log (default the current user)")
+		fs.StringVar(&takedownFlags.reason, "reason", "", "why the change is made, for the audit log")
+	},
+	Run: func(settings *server.Settings, args []string) error {
+		if len(args) == 0 {
+			return usageErrorf("expected takedown subcommand \"delete\", \"restore\", \"list\" or \"audit\"")
+		}
+		tombstones, err := server.OpenTombstones(&settings.OpenPGP.Tombstones)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		switch args[0] {
+		case "delete", "restore":
+			if len(args) < 2 {
+				return usageErrorf("%s requires key fingerprints", args[0])
+			}
+			return takedown(settings, tombstones, args[0], args[1:])
+		case "list":
+			enc := json.NewEncoder(os.Stdout)
+			for _, ts := range tombstones.List() {
+				err := enc.Encode(&ts)
+				if err != nil {
+					return errgo.Mask(err)
+				}
+			}
+			return nil
+		case "audit":
+			enc := json.NewEncoder(os.Stdout)
+			return errgo.Mask(tombstones.AuditLog(func(entry *server.TombstoneAudit) error {
+				return enc.Encode(entry)
+			}))
+		}
+		return usageErrorf("unknown takedown subcommand %q", args[0])
+	},
+}
+
+func takedownActor() string {
+	if takedownFlags.actor != "" {
+		return takedownFlags.actor
+	}
+	actor := "unknown"
+	if u, err := user.Current(); err == nil {
+		actor = u.Username
+	}
+	return actor + " (cli)"
+}
+
+// takedown deletes or restores keys. Restored keys' digests, which are kept
+// in the prefix tree while they are deleted, are removed from it directly,
+// which requires the server to be stopped; the admin API may be used while
+// it is running.
+func takedown(settings *server.Settings, tombstones *server.Tombstones, action string, fingerprints []string) error {
+	st, err := server.DialStorage(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer st.Close()
+
+	backend, err := server.DialKeyBackend(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer backend.Close()
+
+	t := server.NewTakedown(st, backend, tombstones)
+
+	closeJournal, err := journalKeyChanges(settings, st, server.SourceAdmin)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer closeJournal()
+
+	ptree, err := sks.NewPrefixTree(settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ptree.Create()
+	if err != nil {
+		return errgo.Notef(err, "cannot open prefix tree; use the admin API while the server is running")
+	}
+	defer ptree.Close()
+
+	st.Subscribe(func(kc storage.KeyChange) error {
+		for _, digest := range kc.RemoveDigests() {
+			digestZp, err := sks.DigestZp(digest)
+			if err != nil {
+				return errgo.Notef(err, "bad digest %q", digest)
+			}
+			err = ptree.Remove(digestZp)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		return nil
+	})
+
+	actor := takedownActor()
+	if action == "restore" {
+		for _, fp := range fingerprints {
+			err := t.Restore(fp, actor, takedownFlags.reason)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		return nil
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for _, fp := range fingerprints {
+		ts, err := t.Delete(fp, actor, takedownFlags.reason)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		err = enc.Encode(ts)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	return nil
+}
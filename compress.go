@@ -0,0 +1,224 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"gopkg.in/errgo.v1"
+)
+
+// compressibleTypes are the media types of responses which are compressed.
+var compressibleTypes = map[string]bool{
+	"application/javascript": true,
+	"application/json":       true,
+	"application/pgp-keys":   true,
+	"application/xml":        true,
+	"image/svg+xml":          true,
+	"text/css":               true,
+	"text/html":              true,
+	"text/javascript":        true,
+	"text/plain":             true,
+	"text/xml":               true,
+}
+
+// compressMinBytes is the smallest response, by its Content-Length if it has
+// one, worth compressing.
+const compressMinBytes = 256
+
+// Content codings offered to clients.
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// chooseEncoding returns the content coding, br or gzip, most preferred by a
+// request's Accept-Encoding header, or "" if it accepts neither. Brotli wins
+// ties, since it compresses armored keys smaller at a similar cost.
+func chooseEncoding(req *http.Request) string {
+	qs := map[string]float64{}
+	for _, coding := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		params := strings.Split(coding, ";")
+		name := strings.ToLower(strings.TrimSpace(params[0]))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if name == "x-gzip" {
+			name = encodingGzip
+		}
+		qs[name] = q
+	}
+	best, bestQ := "", 0.0
+	for _, name := range []string{encodingBrotli, encodingGzip} {
+		q, ok := qs[name]
+		if !ok {
+			q, ok = qs["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+// compressWriter is implemented by both the gzip and brotli writers.
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// compressor is middleware which compresses textual responses with brotli or
+// gzip, including armored keys, the index pages and webroot assets, for
+// clients accepting them. Streamed responses, such as the change stream, are
+// left alone so that they are not held back in the compressor.
+type compressor struct {
+	pools map[string]*sync.Pool
+}
+
+func newCompressor(settings *CompressionConfig) *compressor {
+	gzipPool := &sync.Pool{New: func() interface{} {
+		w, err := gzip.NewWriterLevel(ioutil.Discard, settings.Level)
+		if err != nil {
+			// The level has been validated.
+			panic(err)
+		}
+		return w
+	}}
+	brotliPool := &sync.Pool{New: func() interface{} {
+		return brotli.NewWriterLevel(ioutil.Discard, settings.BrotliLevel)
+	}}
+	return &compressor{pools: map[string]*sync.Pool{
+		encodingBrotli: brotliPool,
+		encodingGzip:   gzipPool,
+	}}
+}
+
+func (c *compressor) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		encoding := chooseEncoding(req)
+		if req.Method == "HEAD" || encoding == "" {
+			next.ServeHTTP(w, req)
+			return
+		}
+		cw := &compressResponseWriter{ResponseWriter: w, pool: c.pools[encoding], encoding: encoding}
+		defer cw.Close()
+		next.ServeHTTP(cw, req)
+	})
+}
+
+// compressResponseWriter decides whether to compress a response once its
+// header has been written.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	pool        *sync.Pool
+	encoding    string
+	cw          compressWriter
+	wroteHeader bool
+}
+
+// compressible returns whether a response with the given status and header
+// should be compressed.
+func compressible(status int, header http.Header) bool {
+	if status < 200 || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	if n, err := strconv.Atoi(header.Get("Content-Length")); err == nil && n < compressMinBytes {
+		return false
+	}
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(header.Get("Content-Type"), ";")[0]))
+	return compressibleTypes[mediaType]
+}
+
+func (w *compressResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	header := w.Header()
+	header.Add("Vary", "Accept-Encoding")
+	if compressible(status, header) {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		// The compressed representation differs from the uncompressed
+		// one, so it cannot share its strong validator.
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		w.cw = w.pool.Get().(compressWriter)
+		w.cw.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.cw == nil {
+		return w.ResponseWriter.Write(p)
+	}
+	return w.cw.Write(p)
+}
+
+// Flush flushes any compressed data buffered so far to the client.
+func (w *compressResponseWriter) Flush() {
+	if w.cw != nil {
+		w.cw.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements http.CloseNotifier, for handlers which stop
+// streaming when the client goes away.
+func (w *compressResponseWriter) CloseNotify() <-chan bool {
+	if cn, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// Hijack implements http.Hijacker.
+func (w *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errgo.New("connection cannot be hijacked")
+}
+
+// Close completes the compressed response, if any, and returns the
+// compressor to the pool.
+func (w *compressResponseWriter) Close() error {
+	if w.cw == nil {
+		return nil
+	}
+	err := w.cw.Close()
+	w.cw.Reset(ioutil.Discard)
+	w.pool.Put(w.cw)
+	w.cw = nil
+	return errgo.Mask(err)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+)
+
+func TestChooseEncoding(t *testing.T) {
+	for _, test := range []struct {
+		accept, want string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"x-gzip", "gzip"},
+		{"br", "br"},
+		{"gzip, deflate, br", "br"},
+		{"gzip;q=1.0, br;q=0.5", "gzip"},
+		{"br;q=0, gzip", "gzip"},
+		{"br;q=0, gzip;q=0", ""},
+		{"*", "br"},
+		{"*;q=0.5, br;q=0", "gzip"},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept-Encoding", test.accept)
+		if got := chooseEncoding(req); got != test.want {
+			t.Errorf("Accept-Encoding %q: got %q, want %q", test.accept, got, test.want)
+		}
+	}
+}
+
+func TestCompressResponses(t *testing.T) {
+	body := strings.Repeat("-----BEGIN PGP PUBLIC KEY BLOCK-----\n", 64)
+	c := newCompressor(&CompressionConfig{Level: DefaultCompressionLevel, BrotliLevel: DefaultBrotliLevel})
+	h := c.middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/pgp-keys")
+		w.Header().Set("ETag", `"abc"`)
+		io.WriteString(w, body)
+	}))
+
+	for _, test := range []struct {
+		accept, encoding string
+		decode           func(io.Reader) (io.Reader, error)
+	}{
+		{"gzip, br", "br", func(r io.Reader) (io.Reader, error) {
+			return brotli.NewReader(r), nil
+		}},
+		{"gzip", "gzip", func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		}},
+		{"", "", func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		}},
+	} {
+		// Each encoding is requested twice, so that pooled writers are
+		// reused.
+		for i := 0; i < 2; i++ {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/pks/lookup?op=get&search=0x1234", nil)
+			req.Header.Set("Accept-Encoding", test.accept)
+			h.ServeHTTP(rec, req)
+			if got := rec.Header().Get("Content-Encoding"); got != test.encoding {
+				t.Fatalf("Accept-Encoding %q: got encoding %q, want %q", test.accept, got, test.encoding)
+			}
+			if test.encoding != "" && rec.Header().Get("ETag") != `W/"abc"` {
+				t.Errorf("Accept-Encoding %q: got ETag %q, want a weak one", test.accept, rec.Header().Get("ETag"))
+			}
+			r, err := test.decode(rec.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != body {
+				t.Errorf("Accept-Encoding %q: body not decoded", test.accept)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/errgo.v1"
+)
+
+// envPrefix is the prefix of environment variables that override settings.
+//
+// Each setting may be overridden by an environment variable named after its
+// TOML key path, upper-cased and joined with underscores, omitting the
+// top-level "hockeypuck" table. For example, HOCKEYPUCK_HKP_BIND overrides
+// [hockeypuck.hkp] bind, and HOCKEYPUCK_CONFLUX_RECON_PARTNER_PEER1_HTTPADDR
+// overrides [hockeypuck.conflux.recon.partner.peer1] httpAddr. String
+// settings may instead be read from a file named by the same variable with a
+// _FILE suffix, such as HOCKEYPUCK_OPENPGP_DB_DSN_FILE. List settings are
+// comma-separated.
+//
+// The environment overrides the config file, including secrets read from the
+// files it names: HOCKEYPUCK_OPENPGP_DB_DSN takes precedence over dsnFile,
+// and HOCKEYPUCK_OPENPGP_DB_DSNFILE names a file to read the DSN from in
+// place of the one configured.
+const envPrefix = "HOCKEYPUCK"
+
+// applyEnv overrides settings in s from the given environment, a list of
+// "key=value" strings as returned by os.Environ.
+func applyEnv(s *Settings, environ []string) error {
+	env := make(map[string]string)
+	for _, kv := range environ {
+		i := strings.Index(kv, "=")
+		if i < 0 || !strings.HasPrefix(kv, envPrefix+"_") {
+			continue
+		}
+		env[kv[:i]] = kv[i+1:]
+	}
+	if len(env) == 0 {
+		return nil
+	}
+	return errgo.Mask(applyEnvStruct(reflect.ValueOf(s).Elem(), envPrefix, env))
+}
+
+// envName returns the name of the environment variable for a struct field
+// under prefix, and whether the field is flattened into its parent.
+func envName(prefix string, f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("toml")
+	if i := strings.Index(tag, ","); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag == "-" {
+		return "", false
+	}
+	if tag == "" {
+		if f.Anonymous {
+			return prefix, true
+		}
+		tag = f.Name
+	}
+	return prefix + "_" + strings.ToUpper(tag), true
+}
+
+func hasEnvPrefix(env map[string]string, prefix string) bool {
+	for k := range env {
+		if strings.HasPrefix(k, prefix+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+func applyEnvStruct(v reflect.Value, prefix string, env map[string]string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		name, ok := envName(prefix, f)
+		if !ok {
+			continue
+		}
+		err := applyEnvValue(v.Field(i), name, env)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	return errgo.Mask(applyEnvSecretFiles(v, prefix, env))
+}
+
+// applyEnvSecretFiles reads the secrets of a struct from the files named for
+// them in the environment. A secret setting X is read from the file named by
+// its XFile setting, such as DSN from DSNFile, unless X itself is set in the
+// environment.
+func applyEnvSecretFiles(v reflect.Value, prefix string, env map[string]string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type.Kind() != reflect.String || !strings.HasSuffix(f.Name, "File") {
+			continue
+		}
+		secret, ok := t.FieldByName(strings.TrimSuffix(f.Name, "File"))
+		if !ok || secret.Type.Kind() != reflect.String {
+			continue
+		}
+		fileName, _ := envName(prefix, f)
+		secretName, _ := envName(prefix, secret)
+		if _, ok := env[fileName]; !ok {
+			continue
+		}
+		if _, ok := env[secretName]; ok {
+			continue
+		}
+		if _, ok := env[secretName+"_FILE"]; ok {
+			continue
+		}
+		value, err := readSecretFile(v.Field(i).String())
+		if err != nil {
+			return errgo.Notef(err, "%s", fileName)
+		}
+		v.FieldByIndex(secret.Index).SetString(value)
+	}
+	return nil
+}
+
+func applyEnvValue(v reflect.Value, name string, env map[string]string) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		return applyEnvStruct(v, name, env)
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() != reflect.Struct || !hasEnvPrefix(env, name) {
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return applyEnvStruct(v.Elem(), name, env)
+	case reflect.Map:
+		return applyEnvMap(v, name, env)
+	}
+
+	value, ok := env[name]
+	if !ok {
+		fileName, ok := env[name+"_FILE"]
+		if !ok || v.Kind() != reflect.String {
+			return nil
+		}
+		var err error
+		value, err = readSecretFile(fileName)
+		if err != nil {
+			return errgo.Notef(err, "%s_FILE", name)
+		}
+	}
+	err := setEnvValue(v, value)
+	if err != nil {
+		return errgo.Notef(err, "invalid %s", name)
+	}
+	return nil
+}
+
+func setEnvValue(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return errgo.Newf("unsupported type %v", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items).Convert(v.Type()))
+	default:
+		return errgo.Newf("unsupported type %v", v.Type())
+	}
+	return nil
+}
+
+// applyEnvMap overrides entries in a map of structs keyed by name, such as
+// recon partners. Map keys are taken from the environment in lower case.
+func applyEnvMap(v reflect.Value, prefix string, env map[string]string) error {
+	t := v.Type()
+	if t.Key().Kind() != reflect.String || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	keys := make(map[string]bool)
+	for k := range env {
+		if !strings.HasPrefix(k, prefix+"_") {
+			continue
+		}
+		rest := strings.TrimSuffix(k[len(prefix)+1:], "_FILE")
+		for i := 0; i < t.Elem().NumField(); i++ {
+			name, ok := envName("", t.Elem().Field(i))
+			if ok && name != "" && strings.HasSuffix(rest, name) && len(rest) > len(name) {
+				keys[rest[:len(rest)-len(name)]] = true
+			}
+		}
+	}
+	for key := range keys {
+		mapKey := reflect.ValueOf(strings.ToLower(key)).Convert(t.Key())
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		if existing := v.MapIndex(mapKey); existing.IsValid() {
+			elem.Set(existing)
+		}
+		err := applyEnvStruct(elem, prefix+"_"+key, env)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		v.SetMapIndex(mapKey, elem)
+	}
+	return nil
+}
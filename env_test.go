@@ -0,0 +1,58 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestSecret(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, []byte(content+"\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestEnvOverridesSecretFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	confFile := writeTestSecret(t, dir, "conf-dsn", "dbname=conf")
+	envFile := writeTestSecret(t, dir, "env-dsn", "dbname=env")
+	conf := `
+[hockeypuck.openpgp.db]
+driver="postgres-jsonb"
+dsnFile="` + confFile + `"
+`
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		dsn  string
+	}{
+		{"config file", nil, "dbname=conf"},
+		{"env value", map[string]string{"HOCKEYPUCK_OPENPGP_DB_DSN": "dbname=value"}, "dbname=value"},
+		{"env _FILE", map[string]string{"HOCKEYPUCK_OPENPGP_DB_DSN_FILE": envFile}, "dbname=env"},
+		{"env dsnFile", map[string]string{"HOCKEYPUCK_OPENPGP_DB_DSNFILE": envFile}, "dbname=env"},
+	}
+	for _, test := range tests {
+		for k, v := range test.env {
+			os.Setenv(k, v)
+		}
+		settings, err := ParseSettings(conf)
+		for k := range test.env {
+			os.Unsetenv(k)
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if settings.OpenPGP.DB.DSN != test.dsn {
+			t.Errorf("%s: got DSN %q, want %q", test.name, settings.OpenPGP.DB.DSN, test.dsn)
+		}
+	}
+}
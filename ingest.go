@@ -0,0 +1,129 @@
+package server
+
+import (
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// KeyCheck decides whether a key from the given source may be ingested,
+// returning an error to refuse it. A check may also modify the key, in which
+// case it must update the key's digest.
+type KeyCheck func(key *openpgp.PrimaryKey, source string) error
+
+// Ingestion holds the checks applied to keys as they are written to storage,
+// whether submitted over HKP, fetched from recon partners, received by PKS
+// mail or loaded from files.
+type Ingestion struct {
+	Tombstones *Tombstones
+
+	// Policy is nil if no policy file is configured.
+	Policy *Policy
+
+	// Limits is nil if no limits are configured.
+	Limits *Limits
+
+	// StripCertifications is whether third-party certifications are
+	// removed from keys before they are stored.
+	StripCertifications bool
+}
+
+// OpenIngestion opens the tombstones, policy and limits configured in
+// settings.
+func OpenIngestion(settings *Settings) (*Ingestion, error) {
+	tombstones, err := OpenTombstones(&settings.OpenPGP.Tombstones)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	in := &Ingestion{
+		Tombstones:          tombstones,
+		StripCertifications: settings.OpenPGP.StripCertifications == StripOnIngest,
+	}
+	if settings.OpenPGP.PolicyFile != "" {
+		in.Policy, err = OpenPolicy(settings.OpenPGP.PolicyFile)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+	}
+	if settings.OpenPGP.Limits != nil {
+		in.Limits = NewLimits(settings.OpenPGP.Limits)
+	}
+	return in, nil
+}
+
+func (in *Ingestion) checks() []KeyCheck {
+	checks := []KeyCheck{in.Tombstones.Check}
+	if in.Policy != nil {
+		checks = append(checks, in.Policy.Check)
+	}
+	// Stripping and limits may modify the key, so are applied last.
+	// Stripping comes first, as it may bring the key within the limits.
+	if in.StripCertifications {
+		checks = append(checks, stripKey)
+	}
+	if in.Limits != nil {
+		checks = append(checks, in.Limits.Check)
+	}
+	return checks
+}
+
+// Storage returns a view of st through which keys from source are ingested.
+// Keys refused by any check are not written.
+//
+// Keys ingested from recon partners are matched with theirs by digest, so a
+// key which is refused, or changed by a check, stays different from the
+// copies held by partners which accepted it as it was. Partners therefore
+// continue to offer it in recon, and it is fetched and checked again after
+// each session which finds it.
+func (in *Ingestion) Storage(st storage.Storage, source string) storage.Storage {
+	return &ingestStorage{Storage: st, source: source, checks: in.checks()}
+}
+
+type ingestStorage struct {
+	storage.Storage
+	source string
+	checks []KeyCheck
+}
+
+func (st *ingestStorage) check(key *openpgp.PrimaryKey) error {
+	for _, check := range st.checks {
+		err := check(key, st.source)
+		if err != nil {
+			return errgo.Mask(err, errgo.Any)
+		}
+	}
+	return nil
+}
+
+func (st *ingestStorage) Insert(keys []*openpgp.PrimaryKey) (int, error) {
+	var accepted []*openpgp.PrimaryKey
+	var refusedErr error
+	var refused int
+	for _, key := range keys {
+		if err := st.check(key); err != nil {
+			refusedErr = err
+			refused++
+			continue
+		}
+		accepted = append(accepted, key)
+	}
+	var n int
+	var err error
+	if len(accepted) > 0 {
+		n, err = st.Storage.Insert(accepted)
+	}
+	if err != nil {
+		return n, err
+	}
+	if refused > 1 {
+		refusedErr = errgo.WithCausef(refusedErr, errgo.Cause(refusedErr), "%d keys refused, including", refused)
+	}
+	return n, refusedErr
+}
+
+func (st *ingestStorage) Update(key *openpgp.PrimaryKey, priorMD5 string) error {
+	if err := st.check(key); err != nil {
+		return err
+	}
+	return st.Storage.Update(key, priorMD5)
+}
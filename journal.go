@@ -0,0 +1,709 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+const (
+	journalExt = ".jsonl"
+
+	// journalPrunedFile records the ID of the last entry removed from the
+	// journal, so that consumers resuming from an earlier entry can be told
+	// that they have missed changes.
+	journalPrunedFile = "pruned"
+
+	// journalLockFile is locked while appending, so that the server and the
+	// load tool may record changes to the same journal.
+	journalLockFile = "lock"
+
+	// journalSegments is the number of segment files over which the
+	// journal's entries are spread. The oldest segment is removed as a whole
+	// once the journal grows beyond its maximum size.
+	journalSegments = 16
+
+	// journalTailInterval is how often the journal is checked for entries
+	// appended by other processes, such as the load tool. Entries appended
+	// by this process are noticed immediately.
+	journalTailInterval = time.Second
+)
+
+// JournalEntry records a single change to storage.
+type JournalEntry struct {
+	ID          int64     `json:"id,string"`
+	Time        time.Time `json:"time"`
+	Event       string    `json:"event"`
+	Source      string    `json:"source,omitempty"`
+	Fingerprint string    `json:"fingerprint,omitempty"`
+	OldDigest   string    `json:"oldDigest,omitempty"`
+	NewDigest   string    `json:"newDigest"`
+}
+
+// Journal is a bounded, append-only on-disk record of storage key changes.
+// It is stored as a sequence of newline-delimited JSON segment files, named
+// after the ID of their first entry. Entry IDs are derived from the time of
+// the change and increase monotonically in the order in which entries are
+// written, whichever process writes them, so that consumers may resume from
+// the last entry they have seen.
+type Journal struct {
+	dir            string
+	segmentEntries int
+	maxAge         time.Duration
+	lock           *os.File
+
+	mu          sync.Mutex
+	f           *os.File
+	segment     string
+	size        int64
+	segmentLen  int
+	lastID      int64
+	subscribers map[chan struct{}]bool
+	closed      bool
+}
+
+// OpenJournal opens the journal configured by settings, creating it if
+// necessary.
+func OpenJournal(settings *JournalConfig) (*Journal, error) {
+	err := os.MkdirAll(settings.Dir, 0755)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot create journal directory %q", settings.Dir)
+	}
+	segmentEntries := settings.MaxEntries / journalSegments
+	if segmentEntries < 1 {
+		segmentEntries = 1
+	}
+	j := &Journal{
+		dir:            settings.Dir,
+		segmentEntries: segmentEntries,
+		maxAge:         time.Duration(settings.MaxAgeDays) * 24 * time.Hour,
+		subscribers:    make(map[chan struct{}]bool),
+	}
+	j.lock, err = os.OpenFile(filepath.Join(j.dir, journalLockFile), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot open journal %q", settings.Dir)
+	}
+	err = j.withLock(func() error {
+		err := j.open()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		return errgo.Mask(j.prune())
+	})
+	if err != nil {
+		j.lock.Close()
+		return nil, errgo.Notef(err, "cannot open journal %q", settings.Dir)
+	}
+	return j, nil
+}
+
+func (j *Journal) withLock(f func() error) error {
+	err := syscall.Flock(int(j.lock.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		return errgo.Notef(err, "cannot lock journal")
+	}
+	defer syscall.Flock(int(j.lock.Fd()), syscall.LOCK_UN)
+	return f()
+}
+
+// segments returns the names of the journal's segment files, oldest first.
+func (j *Journal) segments() ([]string, error) {
+	fis, err := ioutil.ReadDir(j.dir)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var names []string
+	for _, fi := range fis {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), journalExt) {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func segmentFirstID(name string) int64 {
+	id, _ := strconv.ParseInt(strings.TrimSuffix(name, journalExt), 10, 64)
+	return id
+}
+
+// open opens the most recent segment for appending, recovering the last
+// entry ID written. It must be called with the journal locked.
+func (j *Journal) open() error {
+	if j.f != nil {
+		j.f.Close()
+		j.f = nil
+	}
+	j.segment, j.size, j.segmentLen = "", 0, 0
+	names, err := j.segments()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	name := names[len(names)-1]
+	path := filepath.Join(j.dir, name)
+	err = readJournalSegment(path, func(entry *JournalEntry) error {
+		j.segmentLen++
+		if entry.ID > j.lastID {
+			j.lastID = entry.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errgo.Mask(err)
+	}
+	size := fi.Size()
+	if size > 0 {
+		// Terminate any partial entry left by an interrupted write, so that
+		// it does not corrupt the next one.
+		last := make([]byte, 1)
+		_, err = f.ReadAt(last, size-1)
+		if err == nil && last[0] != '\n' {
+			var n int
+			n, err = f.Write([]byte("\n"))
+			size += int64(n)
+		}
+		if err != nil {
+			f.Close()
+			return errgo.Mask(err)
+		}
+	}
+	j.f, j.segment, j.size = f, name, size
+	return nil
+}
+
+// stale returns whether another process has appended to the journal since
+// it was last opened. It must be called with the journal locked.
+func (j *Journal) stale() (bool, error) {
+	if j.f == nil {
+		names, err := j.segments()
+		return len(names) > 0, errgo.Mask(err)
+	}
+	fi, err := os.Stat(filepath.Join(j.dir, j.segment))
+	if os.IsNotExist(err) {
+		return true, nil
+	} else if err != nil {
+		return false, errgo.Mask(err)
+	}
+	if fi.Size() != j.size {
+		return true, nil
+	}
+	names, err := j.segments()
+	if err != nil {
+		return false, errgo.Mask(err)
+	}
+	return names[len(names)-1] != j.segment, nil
+}
+
+// readJournalSegment calls f for each complete entry in the segment file.
+// A trailing partial line, which may be in the process of being appended,
+// is ignored.
+func readJournalSegment(path string, f func(*JournalEntry) error) error {
+	_, err := readJournalSegmentFrom(path, 0, f)
+	return errgo.Mask(err, errgo.Any)
+}
+
+// readJournalSegmentFrom calls f for each complete entry in the segment file
+// from the given offset, and returns the offset following the last complete
+// entry read.
+func readJournalSegmentFrom(path string, offset int64, f func(*JournalEntry) error) (int64, error) {
+	sf, err := os.Open(path)
+	if os.IsNotExist(err) {
+		// The segment has been pruned since it was listed.
+		return offset, nil
+	} else if err != nil {
+		return offset, errgo.Mask(err)
+	}
+	defer sf.Close()
+	if offset > 0 {
+		_, err = sf.Seek(offset, io.SeekStart)
+		if err != nil {
+			return offset, errgo.Mask(err)
+		}
+	}
+	r := bufio.NewReader(sf)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return offset, nil
+		} else if err != nil {
+			return offset, errgo.Mask(err)
+		}
+		offset += int64(len(line))
+		if len(line) == 1 {
+			continue
+		}
+		var entry JournalEntry
+		err = json.Unmarshal(line, &entry)
+		if err != nil {
+			log.Warningf("invalid journal entry in %q: %v", path, err)
+			continue
+		}
+		err = f(&entry)
+		if err != nil {
+			return offset, errgo.Mask(err, errgo.Any)
+		}
+	}
+}
+
+// Record journals the changes made to st. Each change is attributed to the
+// source returned by source for the key's new digest, or for a deleted key,
+// its old digest.
+func (j *Journal) Record(st storage.Storage, source func(digest string) string) {
+	st.Subscribe(func(kc storage.KeyChange) error {
+		event, oldDigest, newDigest, ok := describeKeyChange(kc)
+		if !ok {
+			return nil
+		}
+		entry := &JournalEntry{
+			Time:      time.Now().UTC(),
+			Event:     event,
+			OldDigest: oldDigest,
+			NewDigest: newDigest,
+		}
+		if kd, ok := kc.(KeyDeleted); ok {
+			// The key is no longer in storage to be looked up.
+			entry.Source = source(kd.Digest)
+			entry.Fingerprint = kd.Fingerprint
+		} else {
+			entry.Source = source(newDigest)
+			rfps, err := st.MatchMD5([]string{newDigest})
+			if err != nil {
+				log.Warningf("cannot look up key %q for journal: %v", newDigest, err)
+			} else if len(rfps) > 0 {
+				entry.Fingerprint = ReverseString(rfps[0])
+			}
+		}
+		err := j.Append(entry)
+		if err != nil {
+			log.Errorf("failed to write journal entry: %v", err)
+		}
+		return nil
+	})
+}
+
+// Append assigns the entry an ID, writes it to the journal and wakes
+// subscribers.
+func (j *Journal) Append(entry *JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.closed {
+		return errgo.New("journal closed")
+	}
+
+	err := j.withLock(func() error {
+		stale, err := j.stale()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		if stale {
+			err = j.open()
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+
+		id := entry.Time.UnixNano()
+		if id <= j.lastID {
+			id = j.lastID + 1
+		}
+		entry.ID = id
+
+		if j.f == nil || j.segmentLen >= j.segmentEntries {
+			err := j.rotate(id)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		buf, err := json.Marshal(entry)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		n, err := j.f.Write(append(buf, '\n'))
+		j.size += int64(n)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		j.segmentLen++
+		j.lastID = id
+		return nil
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	for ch := range j.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// rotate starts a new segment with the given first entry ID, and prunes old
+// segments. It must be called with the journal locked.
+func (j *Journal) rotate(firstID int64) error {
+	if j.f != nil {
+		err := j.f.Close()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		j.f = nil
+	}
+	name := fmt.Sprintf("%020d%s", firstID, journalExt)
+	f, err := os.OpenFile(filepath.Join(j.dir, name), os.O_RDWR|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	j.f, j.segment, j.size, j.segmentLen = f, name, 0, 0
+	return errgo.Mask(j.prune())
+}
+
+// prune removes the oldest segments beyond the journal's size bound, and
+// those containing only entries older than its maximum age. The current
+// segment is never removed. It must be called with the journal locked.
+func (j *Journal) prune() error {
+	names, err := j.segments()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	var cutoff int64
+	if j.maxAge > 0 {
+		cutoff = time.Now().Add(-j.maxAge).UnixNano()
+	}
+	for len(names) > 1 {
+		// Every entry in a segment precedes the first entry of the next.
+		if len(names) <= journalSegments && segmentFirstID(names[1]) > cutoff {
+			break
+		}
+		err = j.pruneSegment(names[0])
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// pruneSegment removes a segment, recording the last entry ID it contained.
+func (j *Journal) pruneSegment(name string) error {
+	path := filepath.Join(j.dir, name)
+	var lastID int64
+	err := readJournalSegment(path, func(entry *JournalEntry) error {
+		if entry.ID > lastID {
+			lastID = entry.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if lastID > 0 {
+		err = ioutil.WriteFile(filepath.Join(j.dir, journalPrunedFile), []byte(strconv.FormatInt(lastID, 10)), 0644)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errgo.Mask(err)
+	}
+	return nil
+}
+
+// pruned returns the ID of the last entry removed from the journal, or 0 if
+// none have been.
+func (j *Journal) pruned() (int64, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(j.dir, journalPrunedFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	id, err := strconv.ParseInt(strings.TrimSpace(string(buf)), 10, 64)
+	if err != nil {
+		return 0, errgo.Notef(err, "invalid %q", journalPrunedFile)
+	}
+	return id, nil
+}
+
+// Complete returns whether every entry after since is still retained in the
+// journal. If not, a consumer resuming from since has missed some changes.
+func (j *Journal) Complete(since int64) (bool, error) {
+	pruned, err := j.pruned()
+	if err != nil {
+		return false, errgo.Mask(err)
+	}
+	return since >= pruned, nil
+}
+
+// Since calls f for each retained entry with an ID greater than since, in
+// order.
+func (j *Journal) Since(since int64, f func(*JournalEntry) error) error {
+	names, err := j.segments()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for i, name := range names {
+		if i+1 < len(names) && segmentFirstID(names[i+1]) <= since {
+			// Every entry in this segment precedes since.
+			continue
+		}
+		err := readJournalSegment(filepath.Join(j.dir, name), func(entry *JournalEntry) error {
+			if entry.ID <= since {
+				return nil
+			}
+			return f(entry)
+		})
+		if err != nil {
+			return errgo.Mask(err, errgo.Any)
+		}
+	}
+	return nil
+}
+
+// JournalQuery selects entries from the journal. Zero-valued fields match
+// every entry.
+type JournalQuery struct {
+	// After selects entries with greater IDs.
+	After int64
+
+	// From and To select entries recorded within a time range, inclusive
+	// of From and exclusive of To.
+	From, To time.Time
+
+	// Fingerprints and KeyIDs select entries for matching keys.
+	Fingerprints []string
+	KeyIDs       []string
+
+	Sources []string
+	Events  []string
+
+	// Limit is the maximum number of entries selected.
+	Limit int
+}
+
+var errQueryDone = errgo.New("query done")
+
+// Query calls f for each entry matching q, in order.
+func (j *Journal) Query(q *JournalQuery, f func(*JournalEntry) error) error {
+	since := q.After
+	if !q.From.IsZero() && q.From.UnixNano()-1 > since {
+		since = q.From.UnixNano() - 1
+	}
+	var until int64
+	if !q.To.IsZero() {
+		until = q.To.UnixNano()
+	}
+	keys := newKeyFilter(q.Fingerprints, q.KeyIDs, nil)
+	sources := stringSet(q.Sources)
+	events := stringSet(q.Events)
+	n := 0
+	err := j.Since(since, func(entry *JournalEntry) error {
+		if until != 0 && entry.ID >= until {
+			return errQueryDone
+		}
+		if !keys.empty() && !keys.matchFingerprint(entry.Fingerprint) {
+			return nil
+		}
+		if sources != nil && !sources[entry.Source] {
+			return nil
+		}
+		if events != nil && !events[entry.Event] {
+			return nil
+		}
+		err := f(entry)
+		if err != nil {
+			return errgo.Mask(err, errgo.Any)
+		}
+		n++
+		if q.Limit > 0 && n >= q.Limit {
+			return errQueryDone
+		}
+		return nil
+	})
+	if errgo.Cause(err) == errQueryDone {
+		return nil
+	}
+	return errgo.Mask(err, errgo.Any)
+}
+
+func stringSet(items []string) map[string]bool {
+	if len(items) == 0 {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
+
+// JournalTail reads the entries appended to the journal after a given
+// entry, by this or any other process, as they are written.
+type JournalTail struct {
+	j       *Journal
+	lastID  int64
+	segment string
+	offset  int64
+}
+
+// LastID returns the ID of the last entry written to the journal by any
+// process, or 0 if it is empty.
+func (j *Journal) LastID() (int64, error) {
+	names, err := j.segments()
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	var lastID int64
+	for i := len(names) - 1; i >= 0 && lastID == 0; i-- {
+		err = readJournalSegment(filepath.Join(j.dir, names[i]), func(entry *JournalEntry) error {
+			lastID = entry.ID
+			return nil
+		})
+		if err != nil {
+			return 0, errgo.Mask(err)
+		}
+	}
+	return lastID, nil
+}
+
+// Tail returns a JournalTail reading entries with IDs greater than since.
+func (j *Journal) Tail(since int64) *JournalTail {
+	return &JournalTail{j: j, lastID: since}
+}
+
+// LastID returns the ID of the last entry read.
+func (t *JournalTail) LastID() int64 {
+	return t.lastID
+}
+
+// Next calls f for each entry written since the last call, in order. It
+// returns whether entries after the last one read have been pruned before
+// they could be read.
+func (t *JournalTail) Next(f func(*JournalEntry) error) (bool, error) {
+	names, err := t.j.segments()
+	if err != nil {
+		return false, errgo.Mask(err)
+	}
+	truncated := false
+	i := sort.SearchStrings(names, t.segment)
+	switch {
+	case t.segment == "":
+		// Start with the segment containing the entry after lastID.
+		i = 0
+		for i+1 < len(names) && segmentFirstID(names[i+1]) <= t.lastID {
+			i++
+		}
+		t.offset = 0
+	case i == len(names) || names[i] != t.segment:
+		// The segment being read has been pruned.
+		complete, err := t.j.Complete(t.lastID)
+		if err != nil {
+			return false, errgo.Mask(err)
+		}
+		truncated = !complete
+		t.offset = 0
+	}
+	for ; i < len(names); i++ {
+		if names[i] != t.segment {
+			t.segment, t.offset = names[i], 0
+		}
+		t.offset, err = readJournalSegmentFrom(filepath.Join(t.j.dir, t.segment), t.offset, func(entry *JournalEntry) error {
+			if entry.ID <= t.lastID {
+				return nil
+			}
+			t.lastID = entry.ID
+			return f(entry)
+		})
+		if err != nil {
+			return truncated, errgo.Mask(err, errgo.Any)
+		}
+	}
+	return truncated, nil
+}
+
+// Subscribe returns a channel which receives a value when this process
+// appends entries to the journal, so that a JournalTail may be read without
+// waiting for the next poll. The channel is closed when the journal is
+// closed.
+func (j *Journal) Subscribe() chan struct{} {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	ch := make(chan struct{}, 1)
+	if j.closed {
+		close(ch)
+		return ch
+	}
+	j.subscribers[ch] = true
+	return ch
+}
+
+// Unsubscribe stops waking a channel returned by Subscribe.
+func (j *Journal) Unsubscribe(ch chan struct{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.subscribers[ch] {
+		delete(j.subscribers, ch)
+		close(ch)
+	}
+}
+
+// Close closes the journal, disconnecting any subscribers.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.closed {
+		return nil
+	}
+	j.closed = true
+	for ch := range j.subscribers {
+		close(ch)
+	}
+	j.subscribers = nil
+	var err error
+	if j.f != nil {
+		err = j.f.Close()
+	}
+	if lockErr := j.lock.Close(); err == nil {
+		err = lockErr
+	}
+	return errgo.Mask(err)
+}
+
+// ReverseString returns s with its characters in reverse order, as used to
+// convert between fingerprints and key IDs and their reversed forms in
+// storage.
+func ReverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestJournalTailOtherProcesses(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	settings := &JournalConfig{Dir: dir, MaxEntries: 1000}
+
+	// A second journal on the same directory stands in for the load tool.
+	server, err := OpenJournal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	load, err := OpenJournal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer load.Close()
+
+	tail := server.Tail(0)
+	var got []string
+	read := func() {
+		_, err := tail.Next(func(entry *JournalEntry) error {
+			got = append(got, entry.Source)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().UTC()
+	for _, e := range []struct {
+		j      *Journal
+		source string
+		time   time.Time
+	}{
+		{server, "hkp", now},
+		// Entries are ordered as written, however their times compare.
+		{load, "load", now.Add(-time.Hour)},
+		{server, "recon", now.Add(-time.Minute)},
+	} {
+		err := e.j.Append(&JournalEntry{Time: e.time, Event: keyEventAdded, Source: e.source})
+		if err != nil {
+			t.Fatal(err)
+		}
+		read()
+	}
+	if len(got) != 3 || got[0] != "hkp" || got[1] != "load" || got[2] != "recon" {
+		t.Errorf("got entries from %q, want hkp, load and recon", got)
+	}
+	lastID, err := load.LastID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastID != tail.LastID() {
+		t.Errorf("got last ID %d, want %d", lastID, tail.LastID())
+	}
+}
+
+func TestJournalTailTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	j, err := OpenJournal(&JournalConfig{Dir: dir, MaxEntries: journalSegments})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.Close()
+
+	err = j.Append(&JournalEntry{Time: time.Now().UTC(), Event: keyEventAdded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tail := j.Tail(0)
+	n := 0
+	count := func(*JournalEntry) error {
+		n++
+		return nil
+	}
+	truncated, err := tail.Next(count)
+	if err != nil || truncated || n != 1 {
+		t.Fatalf("got %d entries, truncated %v, error %v; want 1 entry", n, truncated, err)
+	}
+
+	// Each segment holds one entry, so the segment being read is pruned.
+	for i := 0; i < 2*journalSegments; i++ {
+		err = j.Append(&JournalEntry{Time: time.Now().UTC(), Event: keyEventAdded})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	n = 0
+	truncated, err = tail.Next(count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !truncated {
+		t.Error("pruned entries not reported")
+	}
+	if n != journalSegments {
+		t.Errorf("got %d entries, want the %d retained", n, journalSegments)
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"net/mail"
+	"strings"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// Key change events, as reported to webhooks and other consumers of
+// storage notifications.
+const (
+	keyEventAdded    = "added"
+	keyEventReplaced = "replaced"
+	keyEventDeleted  = "deleted"
+)
+
+// describeKeyChange returns the event name and digests involved in a storage
+// key change. ok is false for changes that did not modify storage.
+func describeKeyChange(kc storage.KeyChange) (event, oldDigest, newDigest string, ok bool) {
+	switch change := kc.(type) {
+	case storage.KeyAdded:
+		return keyEventAdded, "", change.Digest, true
+	case storage.KeyReplaced:
+		return keyEventReplaced, change.OldDigest, change.NewDigest, true
+	case KeyDeleted:
+		return keyEventDeleted, change.Digest, "", true
+	}
+	return "", "", "", false
+}
+
+// uidEmail returns the lower-cased email address in a user ID, or "" if it
+// does not contain one.
+func uidEmail(keywords string) string {
+	if addr, err := mail.ParseAddress(keywords); err == nil {
+		return strings.ToLower(addr.Address)
+	}
+	start, end := strings.LastIndex(keywords, "<"), strings.LastIndex(keywords, ">")
+	if start >= 0 && end > start {
+		if email := keywords[start+1 : end]; strings.Contains(email, "@") {
+			return strings.ToLower(email)
+		}
+	}
+	if fields := strings.Fields(keywords); len(fields) == 1 && strings.Contains(fields[0], "@") {
+		return strings.ToLower(fields[0])
+	}
+	return ""
+}
+
+// emailDomain returns the domain part of an email address.
+func emailDomain(email string) string {
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return email[i+1:]
+	}
+	return ""
+}
+
+// normalizeKeyID returns a key ID or fingerprint in lower-case hex without
+// any 0x prefix.
+func normalizeKeyID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	return strings.TrimPrefix(id, "0x")
+}
+
+// keyFilter matches keys by fingerprint, key ID or user ID email domain. An
+// empty filter matches every key.
+type keyFilter struct {
+	fingerprints []string
+	keyIDs       []string
+	domains      []string
+}
+
+func newKeyFilter(fingerprints, keyIDs, domains []string) *keyFilter {
+	f := &keyFilter{}
+	for _, fp := range fingerprints {
+		f.fingerprints = append(f.fingerprints, normalizeKeyID(fp))
+	}
+	for _, keyID := range keyIDs {
+		f.keyIDs = append(f.keyIDs, normalizeKeyID(keyID))
+	}
+	for _, domain := range domains {
+		f.domains = append(f.domains, strings.ToLower(strings.TrimPrefix(domain, "@")))
+	}
+	return f
+}
+
+func (f *keyFilter) empty() bool {
+	return len(f.fingerprints) == 0 && len(f.keyIDs) == 0 && len(f.domains) == 0
+}
+
+// Match returns whether the key matches any of the filter's criteria.
+func (f *keyFilter) Match(key *openpgp.PrimaryKey) bool {
+	if f.empty() {
+		return true
+	}
+	return f.matchFingerprint(key.Fingerprint()) || f.matchDomains(key)
+}
+
+// matchFingerprint returns whether fp matches any of the filter's
+// fingerprints or key IDs.
+func (f *keyFilter) matchFingerprint(fp string) bool {
+	fp = strings.ToLower(fp)
+	for _, want := range f.fingerprints {
+		if fp == want {
+			return true
+		}
+	}
+	for _, want := range f.keyIDs {
+		if strings.HasSuffix(fp, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDomains returns whether any of the key's user IDs has an email
+// address in one of the filter's domains or their subdomains.
+func (f *keyFilter) matchDomains(key *openpgp.PrimaryKey) bool {
+	for _, uid := range key.UserIDs {
+		domain := emailDomain(uidEmail(uid.Keywords))
+		if domain == "" {
+			continue
+		}
+		for _, want := range f.domains {
+			if domain == want || strings.HasSuffix(domain, "."+want) {
+				return true
+			}
+		}
+	}
+	return false
+}
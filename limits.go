@@ -0,0 +1,218 @@
+package server
+
+import (
+	"crypto/md5"
+	"fmt"
+	"sort"
+
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// ErrKeyTooLarge is the cause of errors resulting from submitting a key which
+// exceeds the configured limits.
+var ErrKeyTooLarge = errgo.New("key exceeds limits")
+
+// Modes of handling keys which exceed the limits.
+const (
+	// LimitModeReject rejects the key.
+	LimitModeReject = "reject"
+
+	// LimitModeTruncate keeps the most recent signatures on each user ID,
+	// self-signatures first, the user IDs with the most recent
+	// self-signatures, and the user attributes within the size limit.
+	LimitModeTruncate = "truncate"
+
+	// LimitModeStrip removes third-party certifications, then truncates
+	// the key if it still exceeds the limits.
+	LimitModeStrip = "strip"
+)
+
+// Limits bounds the size of keys, to defend against certificate flooding.
+// Keys which still exceed the limits after being truncated or stripped are
+// rejected. Partners which accepted a reduced key whole keep offering it;
+// see Ingestion.Storage.
+type Limits struct {
+	settings LimitsConfig
+}
+
+func NewLimits(settings *LimitsConfig) *Limits {
+	return &Limits{settings: *settings}
+}
+
+// packetsSize returns the total size of packets.
+func packetsSize(packets []*openpgp.Packet) int {
+	var n int
+	for _, p := range packets {
+		n += len(p.Packet)
+	}
+	return n
+}
+
+func signaturesSize(sigs []*openpgp.Signature) int {
+	var n int
+	for _, sig := range sigs {
+		n += len(sig.Packet.Packet)
+	}
+	return n
+}
+
+// keySize returns the total size of the key's packets.
+func keySize(key *openpgp.PrimaryKey) int {
+	n := len(key.Packet.Packet) + signaturesSize(key.Signatures) + packetsSize(key.Others)
+	for _, subkey := range key.SubKeys {
+		n += len(subkey.Packet.Packet) + signaturesSize(subkey.Signatures) + packetsSize(subkey.Others)
+	}
+	for _, uid := range key.UserIDs {
+		n += len(uid.Packet.Packet) + signaturesSize(uid.Signatures) + packetsSize(uid.Others)
+	}
+	for _, uat := range key.UserAttributes {
+		n += len(uat.Packet.Packet) + signaturesSize(uat.Signatures) + packetsSize(uat.Others)
+	}
+	return n
+}
+
+// isSelfSignature returns whether sig was made by key.
+func isSelfSignature(key *openpgp.PrimaryKey, sig *openpgp.Signature) bool {
+	return sig.RIssuerKeyID == key.RKeyID
+}
+
+// selfSignatures returns the self-signatures among sigs.
+func selfSignatures(key *openpgp.PrimaryKey, sigs []*openpgp.Signature) []*openpgp.Signature {
+	var result []*openpgp.Signature
+	for _, sig := range sigs {
+		if isSelfSignature(key, sig) {
+			result = append(result, sig)
+		}
+	}
+	return result
+}
+
+// stripCertifications removes third-party certifications from key, returning
+// the number removed.
+func stripCertifications(key *openpgp.PrimaryKey) int {
+	var n int
+	strip := func(sigs []*openpgp.Signature) []*openpgp.Signature {
+		self := selfSignatures(key, sigs)
+		n += len(sigs) - len(self)
+		return self
+	}
+	key.Signatures = strip(key.Signatures)
+	for _, uid := range key.UserIDs {
+		uid.Signatures = strip(uid.Signatures)
+	}
+	for _, uat := range key.UserAttributes {
+		uat.Signatures = strip(uat.Signatures)
+	}
+	return n
+}
+
+// newestSelfSignature returns the creation time in unix seconds of the most
+// recent self-signature in sigs, or 0 if there is none.
+func newestSelfSignature(key *openpgp.PrimaryKey, sigs []*openpgp.Signature) int64 {
+	var newest int64
+	for _, sig := range selfSignatures(key, sigs) {
+		if t := sig.Creation.Unix(); t > newest {
+			newest = t
+		}
+	}
+	return newest
+}
+
+// exceeded describes the first limit which key exceeds, or returns "" if it
+// is within them.
+func (l *Limits) exceeded(key *openpgp.PrimaryKey) string {
+	s := &l.settings
+	if s.MaxUserIDs > 0 && len(key.UserIDs) > s.MaxUserIDs {
+		return fmt.Sprintf("%d user IDs exceeds %d", len(key.UserIDs), s.MaxUserIDs)
+	}
+	if s.MaxUIDSignatures > 0 {
+		for _, uid := range key.UserIDs {
+			if len(uid.Signatures) > s.MaxUIDSignatures {
+				return fmt.Sprintf("%d signatures on a user ID exceeds %d", len(uid.Signatures), s.MaxUIDSignatures)
+			}
+		}
+	}
+	if s.MaxUserAttributeBytes > 0 {
+		for _, uat := range key.UserAttributes {
+			if n := len(uat.Packet.Packet); n > s.MaxUserAttributeBytes {
+				return fmt.Sprintf("%d byte user attribute exceeds %d", n, s.MaxUserAttributeBytes)
+			}
+		}
+	}
+	if s.MaxKeyBytes > 0 {
+		if n := keySize(key); n > s.MaxKeyBytes {
+			return fmt.Sprintf("%d bytes exceeds %d", n, s.MaxKeyBytes)
+		}
+	}
+	return ""
+}
+
+// truncate reduces key to within the signature, user ID and user attribute
+// limits.
+func (l *Limits) truncate(key *openpgp.PrimaryKey) {
+	s := &l.settings
+	if s.MaxUIDSignatures > 0 {
+		for _, uid := range key.UserIDs {
+			if len(uid.Signatures) <= s.MaxUIDSignatures {
+				continue
+			}
+			sigs := uid.Signatures
+			sort.SliceStable(sigs, func(i, j int) bool {
+				selfI, selfJ := isSelfSignature(key, sigs[i]), isSelfSignature(key, sigs[j])
+				if selfI != selfJ {
+					return selfI
+				}
+				return sigs[i].Creation.After(sigs[j].Creation)
+			})
+			uid.Signatures = sigs[:s.MaxUIDSignatures]
+		}
+	}
+	if s.MaxUserIDs > 0 && len(key.UserIDs) > s.MaxUserIDs {
+		uids := key.UserIDs
+		sort.SliceStable(uids, func(i, j int) bool {
+			return newestSelfSignature(key, uids[i].Signatures) > newestSelfSignature(key, uids[j].Signatures)
+		})
+		key.UserIDs = uids[:s.MaxUserIDs]
+	}
+	if s.MaxUserAttributeBytes > 0 {
+		var uats []*openpgp.UserAttribute
+		for _, uat := range key.UserAttributes {
+			if len(uat.Packet.Packet) <= s.MaxUserAttributeBytes {
+				uats = append(uats, uat)
+			}
+		}
+		key.UserAttributes = uats
+	}
+}
+
+// Check enforces the limits on key, rejecting it or reducing it in place
+// according to the configured mode. A reduced key is given a new digest.
+func (l *Limits) Check(key *openpgp.PrimaryKey, source string) error {
+	reason := l.exceeded(key)
+	if reason == "" {
+		return nil
+	}
+	fields := log.Fields{
+		"fingerprint": key.Fingerprint(),
+		"source":      source,
+		"limit":       reason,
+	}
+	switch l.settings.Mode {
+	case LimitModeStrip:
+		fields["stripped"] = stripCertifications(key)
+		l.truncate(key)
+	case LimitModeTruncate:
+		l.truncate(key)
+	}
+	if l.settings.Mode != LimitModeReject {
+		if after := l.exceeded(key); after == "" {
+			key.MD5 = openpgp.SksDigest(key, md5.New())
+			log.WithFields(fields).Infof("key reduced to within limits (%s)", l.settings.Mode)
+			return nil
+		}
+	}
+	log.WithFields(fields).Warning("key rejected by limits")
+	return errgo.WithCausef(nil, ErrKeyTooLarge, "key %s rejected: %s", key.Fingerprint(), reason)
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"encoding/binary"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	hashqueryPath = "/pks/hashquery"
+
+	// partnerSessionGap separates recon sessions with a partner: hashquery
+	// exchanges with the partner closer together than this are counted as
+	// part of the same session.
+	partnerSessionGap = 30 * time.Second
+
+	// partnerCheckInterval is how often each active partner's recon address
+	// is connected to, and partner host names are resolved again to match
+	// them with the addresses of hashquery requests.
+	partnerCheckInterval = 5 * time.Minute
+
+	partnerDialTimeout = 30 * time.Second
+)
+
+// partnerStatus is the runtime status of a recon partner, as shown in stats.
+type partnerStatus struct {
+	LastContact         string `json:"lastContact,omitempty"`
+	LastError           string `json:"lastError,omitempty"`
+	LastErrorTime       string `json:"lastErrorTime,omitempty"`
+	KeysSent            int    `json:"keysSent"`
+	SessionDuration     string `json:"sessionDuration,omitempty"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+}
+
+type partnerState struct {
+	sessionStart, sessionEnd time.Time
+	sent                     int
+
+	lastContact   time.Time
+	lastError     string
+	lastErrorTime time.Time
+	failures      int
+}
+
+// partnerTracker observes the status of recon with each partner.
+//
+// The sks peer runs its recon sessions and fetches the keys they find
+// without reporting on them, so partners are observed from outside it. Each
+// active partner's recon address is connected to periodically, so that
+// partners which cannot be reached are shown with their error, and the keys
+// each partner fetches from us with a hashquery after a session are counted.
+// Sessions themselves, and the keys we fetch from partners, are not seen.
+type partnerTracker struct {
+	partners func() []partnerInfo
+	wake     chan struct{}
+
+	mu     sync.Mutex
+	addrs  map[string]string
+	states map[string]*partnerState
+
+	started bool
+	t       tomb.Tomb
+}
+
+func newPartnerTracker(partners func() []partnerInfo) *partnerTracker {
+	return &partnerTracker{
+		partners: partners,
+		wake:     make(chan struct{}, 1),
+		addrs:    make(map[string]string),
+		states:   make(map[string]*partnerState),
+	}
+}
+
+// invalidate causes partners to be checked again, after the partner set has
+// changed.
+func (t *partnerTracker) invalidate() {
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start starts checking partners in the background.
+func (t *partnerTracker) Start() {
+	t.started = true
+	t.t.Go(t.checkLoop)
+}
+
+// Stop stops checking partners.
+func (t *partnerTracker) Stop() error {
+	if !t.started {
+		return nil
+	}
+	t.t.Kill(nil)
+	return t.t.Wait()
+}
+
+func (t *partnerTracker) checkLoop() error {
+	for {
+		t.check()
+		select {
+		case <-t.t.Dying():
+			return nil
+		case <-t.wake:
+		case <-time.After(partnerCheckInterval):
+		}
+	}
+}
+
+// check resolves the partners' host names and connects to the recon address
+// of each active partner. Names are resolved and connections made without
+// t.mu held, so that a slow resolver or partner does not hold up requests.
+func (t *partnerTracker) check() {
+	partners := t.partners()
+	addrs := make(map[string]string)
+	for _, partner := range partners {
+		for _, addr := range []string{partner.HTTPAddr, partner.ReconAddr} {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				continue
+			}
+			ips, err := net.LookupHost(host)
+			if err != nil {
+				log.Debugf("cannot resolve partner %q host %q: %v", partner.Name, host, err)
+				continue
+			}
+			addrs[host] = partner.Name
+			for _, ip := range ips {
+				addrs[ip] = partner.Name
+			}
+		}
+	}
+	t.mu.Lock()
+	t.addrs = addrs
+	t.mu.Unlock()
+
+	for _, partner := range partners {
+		if partner.Paused {
+			continue
+		}
+		select {
+		case <-t.t.Dying():
+			return
+		default:
+		}
+		conn, err := net.DialTimeout("tcp", partner.ReconAddr, partnerDialTimeout)
+		if err != nil {
+			t.failed(partner.Name, errgo.Notef(err, "recon"))
+			continue
+		}
+		conn.Close()
+		t.contacted(partner.Name)
+	}
+}
+
+// state returns the state of the named partner. It must be called with t.mu
+// held.
+func (t *partnerTracker) state(name string) *partnerState {
+	ps, ok := t.states[name]
+	if !ok {
+		ps = &partnerState{}
+		t.states[name] = ps
+	}
+	return ps
+}
+
+func (t *partnerTracker) contacted(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps := t.state(name)
+	ps.lastContact = time.Now()
+	ps.failures = 0
+}
+
+func (t *partnerTracker) failed(name string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps := t.state(name)
+	ps.lastError = err.Error()
+	ps.lastErrorTime = time.Now()
+	ps.failures++
+}
+
+// sent records keys fetched by the partner with the given host address with
+// a hashquery, starting a new session if the last was long enough ago.
+func (t *partnerTracker) sent(host string, start time.Time, n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	name, ok := t.addrs[host]
+	if !ok {
+		return
+	}
+	ps := t.state(name)
+	now := time.Now()
+	if start.Sub(ps.sessionEnd) > partnerSessionGap {
+		ps.sessionStart, ps.sent = start, 0
+	}
+	ps.sessionEnd = now
+	ps.sent += n
+	ps.lastContact = now
+	ps.failures = 0
+}
+
+// Status returns the observed status of the named partner.
+func (t *partnerTracker) Status(name string) *partnerStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps, ok := t.states[name]
+	if !ok {
+		return &partnerStatus{}
+	}
+	status := &partnerStatus{
+		KeysSent:            ps.sent,
+		LastError:           ps.lastError,
+		ConsecutiveFailures: ps.failures,
+	}
+	if !ps.sessionStart.IsZero() {
+		status.SessionDuration = ps.sessionEnd.Sub(ps.sessionStart).String()
+	}
+	if !ps.lastContact.IsZero() {
+		status.LastContact = ps.lastContact.UTC().Format(time.RFC3339)
+	}
+	if !ps.lastErrorTime.IsZero() {
+		status.LastErrorTime = ps.lastErrorTime.UTC().Format(time.RFC3339)
+	}
+	return status
+}
+
+// hashqueryCount captures the number of keys in a hashquery response, which
+// begins with the count as a 32-bit big-endian integer.
+type hashqueryCount struct {
+	prefix []byte
+}
+
+func (c *hashqueryCount) capture(p []byte) {
+	if need := 4 - len(c.prefix); need > 0 {
+		if need > len(p) {
+			need = len(p)
+		}
+		c.prefix = append(c.prefix, p[:need]...)
+	}
+}
+
+func (c *hashqueryCount) count() (int, bool) {
+	if len(c.prefix) < 4 {
+		return 0, false
+	}
+	return int(binary.BigEndian.Uint32(c.prefix)), true
+}
+
+// hashqueryResponseWriter captures the number of keys in the hashquery
+// response sent to a partner.
+type hashqueryResponseWriter struct {
+	http.ResponseWriter
+	hashqueryCount
+	status int
+}
+
+func (w *hashqueryResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *hashqueryResponseWriter) Write(p []byte) (int, error) {
+	w.capture(p)
+	return w.ResponseWriter.Write(p)
+}
+
+// middleware observes hashquery requests made by partners.
+func (t *partnerTracker) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != hashqueryPath {
+			next.ServeHTTP(rw, req)
+			return
+		}
+		start := time.Now()
+		w := &hashqueryResponseWriter{ResponseWriter: rw, status: http.StatusOK}
+		next.ServeHTTP(w, req)
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			return
+		}
+		if n, ok := w.count(); ok && w.status == http.StatusOK {
+			t.sent(host, start, n)
+		}
+	})
+}
@@ -0,0 +1,455 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	pksPollInterval  = 30 * time.Second
+	pksMinRetry      = time.Minute
+	pksMaxRetry      = 6 * time.Hour
+	pksMaxBatch      = 100
+	pksIgnoreTimeout = time.Hour
+)
+
+// pksJob is a pending outbound PKS sync mail for one key.
+type pksJob struct {
+	Digest      string    `json:"digest"`
+	To          string    `json:"to"`
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	LastError   string    `json:"lastError,omitempty"`
+}
+
+// pksSync implements the PKS email synchronization protocol. Keys added or
+// replaced in storage are mailed to each configured peer address, and keys
+// received by mail are upserted into storage.
+type pksSync struct {
+	settings *PKSConfig
+	st       storage.Storage
+	spool    *spool
+	wake     chan struct{}
+
+	mu sync.Mutex
+	// ignore holds the fingerprints of keys recently received from PKS
+	// peers, which are not sent back out.
+	ignore map[string]time.Time
+
+	t tomb.Tomb
+}
+
+func newPKSSync(st storage.Storage, settings *PKSConfig) (*pksSync, error) {
+	if settings.From == "" {
+		return nil, errgo.New("PKS sync requires a from address")
+	}
+	sp, err := newSpool(settings.QueueDir)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot open PKS queue %q", settings.QueueDir)
+	}
+	p := &pksSync{
+		settings: settings,
+		st:       st,
+		spool:    sp,
+		wake:     make(chan struct{}, 1),
+		ignore:   make(map[string]time.Time),
+	}
+	st.Subscribe(p.keyChanged)
+	return p, nil
+}
+
+func (p *pksSync) Start() {
+	p.t.Go(p.sendLoop)
+	if p.settings.Maildir != "" {
+		p.t.Go(p.maildirLoop)
+	}
+	if p.settings.Bind != "" {
+		p.t.Go(p.listenAndServeSMTP)
+	}
+}
+
+func (p *pksSync) Stop() error {
+	p.t.Kill(nil)
+	return p.t.Wait()
+}
+
+// keyChanged queues a sync mail to each peer address when a key is added or
+// replaced.
+func (p *pksSync) keyChanged(kc storage.KeyChange) error {
+	_, _, digest, ok := describeKeyChange(kc)
+	if !ok || digest == "" {
+		return nil
+	}
+	for _, to := range p.settings.To {
+		err := p.spool.Put(&pksJob{Digest: digest, To: to})
+		if err != nil {
+			log.Errorf("failed to queue PKS sync of %q to %q: %v", digest, to, err)
+		}
+	}
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (p *pksSync) ignoreKey(rfp string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for k, t := range p.ignore {
+		if now.Sub(t) > pksIgnoreTimeout {
+			delete(p.ignore, k)
+		}
+	}
+	p.ignore[rfp] = now
+}
+
+func (p *pksSync) ignored(rfp string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.ignore[rfp]
+	return ok && time.Since(t) <= pksIgnoreTimeout
+}
+
+func (p *pksSync) sendLoop() error {
+	for {
+		err := p.sendPending()
+		if err != nil {
+			log.Errorf("PKS sync: %v", err)
+		}
+		select {
+		case <-p.t.Dying():
+			return nil
+		case <-p.wake:
+		case <-time.After(pksPollInterval):
+		}
+	}
+}
+
+// sendPending sends all queued jobs that are due, batching keys bound for
+// the same address into a single mail.
+func (p *pksSync) sendPending() error {
+	names, err := p.spool.List()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	now := time.Now()
+	batches := make(map[string][]string)
+	jobs := make(map[string]*pksJob)
+	for _, name := range names {
+		var job pksJob
+		err := p.spool.Get(name, &job)
+		if err != nil {
+			log.Errorf("invalid PKS queue entry %q: %v", name, err)
+			p.spool.Fail(name)
+			continue
+		}
+		if job.NextAttempt.After(now) || len(batches[job.To]) >= pksMaxBatch {
+			continue
+		}
+		batches[job.To] = append(batches[job.To], name)
+		jobs[name] = &job
+	}
+
+	for to, batch := range batches {
+		select {
+		case <-p.t.Dying():
+			return nil
+		default:
+		}
+		var digests []string
+		for _, name := range batch {
+			digests = append(digests, jobs[name].Digest)
+		}
+		err := p.send(to, digests)
+		for _, name := range batch {
+			job := jobs[name]
+			if err == nil {
+				p.spool.Remove(name)
+				continue
+			}
+			job.Attempts++
+			job.LastError = err.Error()
+			if p.settings.MaxAttempts > 0 && job.Attempts >= p.settings.MaxAttempts {
+				log.Errorf("giving up PKS sync of %q to %q after %d attempts: %v", job.Digest, to, job.Attempts, err)
+				p.spool.Fail(name)
+				continue
+			}
+			job.NextAttempt = time.Now().Add(retryBackoff(job.Attempts, pksMinRetry, pksMaxRetry))
+			p.spool.Update(name, job)
+		}
+		if err != nil {
+			log.Warningf("PKS sync of %d keys to %q failed: %v", len(digests), to, err)
+		} else {
+			log.Debugf("PKS sync of %d keys sent to %q", len(digests), to)
+		}
+	}
+	return nil
+}
+
+// send mails the current versions of the keys matching digests to the given
+// address. Keys which have since been replaced or were received from a PKS
+// peer are skipped.
+func (p *pksSync) send(to string, digests []string) error {
+	rfps, err := p.st.MatchMD5(digests)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	var sendRfps []string
+	for _, rfp := range rfps {
+		if !p.ignored(rfp) {
+			sendRfps = append(sendRfps, rfp)
+		}
+	}
+	if len(sendRfps) == 0 {
+		return nil
+	}
+	keys, err := p.st.FetchKeys(sendRfps)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", p.settings.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: incremental\r\n")
+	fmt.Fprintf(&msg, "X-KeyServer-Sent: %s\r\n", p.settings.From)
+	fmt.Fprintf(&msg, "Precedence: list\r\n")
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: application/pgp-keys\r\n\r\n")
+	err = openpgp.WriteArmoredPackets(&msg, keys)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	return errgo.Mask(sendMail(&p.settings.SMTP, p.settings.From, to, msg.Bytes()))
+}
+
+// sendMail sends msg, which includes its headers, from one address to another
+// through the configured SMTP server.
+func sendMail(settings *SMTPConfig, from, to string, msg []byte) error {
+	var auth smtp.Auth
+	if settings.User != "" {
+		host, _, err := net.SplitHostPort(settings.Host)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		auth = smtp.PlainAuth(settings.ID, settings.User, settings.Password, host)
+	}
+	return errgo.Mask(smtp.SendMail(settings.Host, auth, from, []string{to}, msg))
+}
+
+// receive handles an inbound PKS mail. Mails with the subject "ADD" are key
+// submissions from users, and "incremental" are sync mails from PKS peers.
+func (p *pksSync) receive(r io.Reader) error {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	subject := strings.TrimSpace(msg.Header.Get("Subject"))
+	fromPeer := strings.EqualFold(subject, "incremental")
+	if !fromPeer && !strings.EqualFold(subject, "ADD") {
+		return errgo.Newf("unsupported PKS mail subject %q", subject)
+	}
+	if fromPeer && strings.Contains(msg.Header.Get("X-KeyServer-Sent"), p.settings.From) {
+		// Our own sync mail came back to us.
+		return nil
+	}
+
+	keyResults, err := openpgp.ReadArmorKeys(msg.Body)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	var n int
+	for kr := range keyResults {
+		if kr.Error != nil {
+			log.Warningf("error reading key from PKS mail: %v", kr.Error)
+			continue
+		}
+		if fromPeer {
+			p.ignoreKey(kr.PrimaryKey.RFingerprint)
+		}
+		_, err := storage.UpsertKey(p.st, kr.PrimaryKey)
+		if err != nil {
+			log.Errorf("failed to upsert key %q from PKS mail: %v", kr.PrimaryKey.Fingerprint(), err)
+			continue
+		}
+		n++
+	}
+	log.Infof("PKS mail %q from %q: %d keys upserted", subject, msg.Header.Get("From"), n)
+	return nil
+}
+
+// maildirLoop periodically processes new mails delivered to the configured
+// maildir, moving them to its cur directory once handled.
+func (p *pksSync) maildirLoop() error {
+	for {
+		err := p.processMaildir()
+		if err != nil {
+			log.Errorf("PKS maildir %q: %v", p.settings.Maildir, err)
+		}
+		select {
+		case <-p.t.Dying():
+			return nil
+		case <-time.After(pksPollInterval):
+		}
+	}
+}
+
+func (p *pksSync) processMaildir() error {
+	newDir := filepath.Join(p.settings.Maildir, "new")
+	curDir := filepath.Join(p.settings.Maildir, "cur")
+	fis, err := ioutil.ReadDir(newDir)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for _, fi := range fis {
+		if fi.IsDir() {
+			continue
+		}
+		path := filepath.Join(newDir, fi.Name())
+		f, err := os.Open(path)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		err = p.receive(f)
+		f.Close()
+		if err != nil {
+			log.Warningf("failed to process PKS mail %q: %v", path, err)
+		}
+		err = os.Rename(path, filepath.Join(curDir, fi.Name()+":2,S"))
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	return nil
+}
+
+// listenAndServeSMTP accepts PKS mails delivered over SMTP to the configured
+// bind address.
+func (p *pksSync) listenAndServeSMTP() error {
+	ln, err := net.Listen("tcp", p.settings.Bind)
+	if err != nil {
+		return errgo.Notef(err, "cannot listen for PKS mail on %q", p.settings.Bind)
+	}
+	p.t.Go(func() error {
+		<-p.t.Dying()
+		return ln.Close()
+	})
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			select {
+			case <-p.t.Dying():
+				return nil
+			default:
+			}
+			return errgo.Mask(err)
+		}
+		go func() {
+			defer conn.Close()
+			err := p.serveSMTP(conn)
+			if err != nil {
+				log.Warningf("PKS SMTP session from %v: %v", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+const smtpSessionTimeout = 5 * time.Minute
+
+// serveSMTP implements the minimal subset of SMTP needed to receive mail from
+// a local MTA or a PKS peer.
+func (p *pksSync) serveSMTP(conn net.Conn) error {
+	conn.SetDeadline(time.Now().Add(smtpSessionTimeout))
+	tc := textproto.NewConn(conn)
+	tc.PrintfLine("220 %s Hockeypuck PKS ready", hostname())
+	var haveRcpt bool
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		verb := strings.ToUpper(line)
+		if i := strings.IndexAny(verb, " :"); i >= 0 {
+			verb = verb[:i]
+		}
+		switch verb {
+		case "HELO":
+			tc.PrintfLine("250 %s", hostname())
+		case "EHLO":
+			tc.PrintfLine("250-%s", hostname())
+			tc.PrintfLine("250 SIZE %d", p.settings.MaxMessageBytes)
+		case "MAIL":
+			haveRcpt = false
+			tc.PrintfLine("250 OK")
+		case "RCPT":
+			haveRcpt = true
+			tc.PrintfLine("250 OK")
+		case "DATA":
+			if !haveRcpt {
+				tc.PrintfLine("503 need RCPT first")
+				continue
+			}
+			tc.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			dr := tc.DotReader()
+			data, err := ioutil.ReadAll(io.LimitReader(dr, int64(p.settings.MaxMessageBytes)+1))
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			if len(data) > p.settings.MaxMessageBytes {
+				// Discard the rest of the message to find its end.
+				_, err = io.Copy(ioutil.Discard, dr)
+				if err != nil {
+					return errgo.Mask(err)
+				}
+				tc.PrintfLine("552 message exceeds maximum size of %d bytes", p.settings.MaxMessageBytes)
+				haveRcpt = false
+				continue
+			}
+			err = p.receive(bytes.NewReader(data))
+			if err != nil {
+				tc.PrintfLine("554 %v", err)
+			} else {
+				tc.PrintfLine("250 OK")
+			}
+			haveRcpt = false
+		case "RSET":
+			haveRcpt = false
+			tc.PrintfLine("250 OK")
+		case "NOOP":
+			tc.PrintfLine("250 OK")
+		case "QUIT":
+			tc.PrintfLine("221 bye")
+			return nil
+		default:
+			tc.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "localhost"
+	}
+	return name
+}
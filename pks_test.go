@@ -0,0 +1,440 @@
+package server
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// testStorage is an in-memory storage holding keys by reversed fingerprint.
+// Methods not needed by the tests panic through the nil embedded Storage.
+type testStorage struct {
+	storage.Storage
+
+	mu          sync.Mutex
+	keys        map[string]*openpgp.PrimaryKey
+	subscribers []func(storage.KeyChange) error
+}
+
+func newTestStorage(keys ...*openpgp.PrimaryKey) *testStorage {
+	st := &testStorage{keys: make(map[string]*openpgp.PrimaryKey)}
+	for _, key := range keys {
+		st.keys[key.RFingerprint] = key
+	}
+	return st
+}
+
+func (st *testStorage) Subscribe(f func(storage.KeyChange) error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.subscribers = append(st.subscribers, f)
+}
+
+func (st *testStorage) MatchMD5(digests []string) ([]string, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	var rfps []string
+	for _, digest := range digests {
+		for rfp, key := range st.keys {
+			if key.MD5 == digest {
+				rfps = append(rfps, rfp)
+			}
+		}
+	}
+	return rfps, nil
+}
+
+func (st *testStorage) MatchKeyword(search []string) ([]string, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	var rfps []string
+	for rfp, key := range st.keys {
+		for _, uid := range key.UserIDs {
+			if containsAll(strings.ToLower(uid.Keywords), search) {
+				rfps = append(rfps, rfp)
+				break
+			}
+		}
+	}
+	return rfps, nil
+}
+
+func containsAll(s string, words []string) bool {
+	for _, word := range words {
+		if !strings.Contains(s, strings.ToLower(word)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (st *testStorage) FetchKeys(rfps []string) ([]*openpgp.PrimaryKey, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	var keys []*openpgp.PrimaryKey
+	for _, rfp := range rfps {
+		if key, ok := st.keys[rfp]; ok {
+			// Callers may modify the keys they fetch.
+			copied := *key
+			copied.UserIDs = append([]*openpgp.UserID(nil), key.UserIDs...)
+			keys = append(keys, &copied)
+		}
+	}
+	return keys, nil
+}
+
+// testMail is a mail received by a testSMTPServer.
+type testMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// testSMTPServer is a local SMTP stand-in, which receives mails or, if
+// rejectCode is set, rejects them with that code.
+type testSMTPServer struct {
+	ln         net.Listener
+	mails      chan testMail
+	rejectCode int
+}
+
+func newTestSMTPServer(t *testing.T) *testSMTPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testSMTPServer{ln: ln, mails: make(chan testMail, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testSMTPServer) Addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *testSMTPServer) Close() {
+	s.ln.Close()
+}
+
+func (s *testSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	tc := textproto.NewConn(conn)
+	tc.PrintfLine("220 localhost test SMTP ready")
+	var mail testMail
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		upper := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			tc.PrintfLine("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			mail = testMail{from: strings.Trim(line[len("MAIL FROM:"):], "<> ")}
+			tc.PrintfLine("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			mail.to = append(mail.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			tc.PrintfLine("250 OK")
+		case upper == "DATA":
+			tc.PrintfLine("354 go ahead")
+			data, err := ioutil.ReadAll(tc.DotReader())
+			if err != nil {
+				return
+			}
+			if s.rejectCode != 0 {
+				tc.PrintfLine("%d rejected", s.rejectCode)
+				continue
+			}
+			mail.data = string(data)
+			s.mails <- mail
+			tc.PrintfLine("250 OK")
+		case upper == "QUIT":
+			tc.PrintfLine("221 bye")
+			return
+		default:
+			tc.PrintfLine("250 OK")
+		}
+	}
+}
+
+func (s *testSMTPServer) nextMail(t *testing.T) testMail {
+	select {
+	case mail := <-s.mails:
+		return mail
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for mail")
+	}
+	panic("unreachable")
+}
+
+// dialPKSSMTP serves one SMTP session of p on a local listener and returns a
+// client connected to it.
+func dialPKSSMTP(t *testing.T, p *pksSync) *smtp.Client {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		p.serveSMTP(conn)
+	}()
+	c, err := smtp.Dial(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func sendTestMail(c *smtp.Client, msg string) error {
+	err := c.Mail("alice@example.com")
+	if err != nil {
+		return err
+	}
+	err = c.Rcpt("pgp-public-keys@example.com")
+	if err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write([]byte(msg))
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func smtpCode(err error) int {
+	if tperr, ok := err.(*textproto.Error); ok {
+		return tperr.Code
+	}
+	return 0
+}
+
+func TestPKSServeSMTP(t *testing.T) {
+	p := &pksSync{
+		settings: &PKSConfig{From: "pgp-public-keys@example.com", MaxMessageBytes: 1024},
+		ignore:   make(map[string]time.Time),
+	}
+	c := dialPKSSMTP(t, p)
+	defer c.Close()
+
+	ok, param := c.Extension("SIZE")
+	if !ok || param != "1024" {
+		t.Errorf("got SIZE extension %v %q, want 1024", ok, param)
+	}
+
+	// Our own sync mail is accepted and ignored.
+	looped := "From: pgp-public-keys@example.com\r\n" +
+		"Subject: incremental\r\n" +
+		"X-KeyServer-Sent: pgp-public-keys@example.com\r\n\r\n"
+	err := sendTestMail(c, looped)
+	if err != nil {
+		t.Fatalf("own sync mail: %v", err)
+	}
+
+	err = sendTestMail(c, "Subject: hello\r\n\r\nhello\r\n")
+	if code := smtpCode(err); code != 554 {
+		t.Errorf("unsupported subject: got %v, want 554", err)
+	}
+
+	err = sendTestMail(c, looped+strings.Repeat("x", 2048)+"\r\n")
+	if code := smtpCode(err); code != 552 {
+		t.Errorf("oversized mail: got %v, want 552", err)
+	}
+
+	// The session remains usable after an oversized mail.
+	err = sendTestMail(c, looped)
+	if err != nil {
+		t.Fatalf("mail after oversized mail: %v", err)
+	}
+	err = c.Quit()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPKSDataRequiresRcpt(t *testing.T) {
+	p := &pksSync{settings: &PKSConfig{MaxMessageBytes: 1024}}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		p.serveSMTP(conn)
+	}()
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	tc := textproto.NewConn(conn)
+	_, _, err = tc.ReadResponse(220)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := tc.Cmd("DATA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.StartResponse(id)
+	_, _, err = tc.ReadResponse(354)
+	tc.EndResponse(id)
+	if code := smtpCode(err); code != 503 {
+		t.Errorf("got %v, want 503", err)
+	}
+}
+
+func newTestPKSSync(t *testing.T, st storage.Storage, smtpAddr string) (*pksSync, string) {
+	dir, err := ioutil.TempDir("", "hockeypuck-pks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := newPKSSync(st, &PKSConfig{
+		From:        "pgp-public-keys@example.com",
+		To:          []string{"pgp-public-keys@peer.example.org"},
+		SMTP:        SMTPConfig{Host: smtpAddr},
+		QueueDir:    dir,
+		MaxAttempts: 3,
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return p, dir
+}
+
+func TestPKSSendQueued(t *testing.T) {
+	srv := newTestSMTPServer(t)
+	defer srv.Close()
+	key := &openpgp.PrimaryKey{MD5: "digest1"}
+	key.RFingerprint = "rfp1"
+	st := newTestStorage(key)
+	p, dir := newTestPKSSync(t, st, srv.Addr())
+	defer os.RemoveAll(dir)
+	if len(st.subscribers) != 1 {
+		t.Fatalf("got %d subscribers, want 1", len(st.subscribers))
+	}
+
+	err := st.subscribers[0](storage.KeyAdded{Digest: "digest1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := p.spool.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("got %d queued mails, want 1", len(names))
+	}
+
+	err = p.sendPending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mail := srv.nextMail(t)
+	if mail.from != "pgp-public-keys@example.com" {
+		t.Errorf("got from %q", mail.from)
+	}
+	if len(mail.to) != 1 || mail.to[0] != "pgp-public-keys@peer.example.org" {
+		t.Errorf("got to %q", mail.to)
+	}
+	r := textproto.NewReader(bufio.NewReader(strings.NewReader(mail.data)))
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Get("Subject") != "incremental" {
+		t.Errorf("got subject %q, want incremental", header.Get("Subject"))
+	}
+	if header.Get("X-KeyServer-Sent") != "pgp-public-keys@example.com" {
+		t.Errorf("got X-KeyServer-Sent %q", header.Get("X-KeyServer-Sent"))
+	}
+
+	names, err = p.spool.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 0 {
+		t.Errorf("got %d queued mails after sending, want 0", len(names))
+	}
+}
+
+func TestPKSSendRetried(t *testing.T) {
+	srv := newTestSMTPServer(t)
+	defer srv.Close()
+	srv.rejectCode = 451
+	key := &openpgp.PrimaryKey{MD5: "digest1"}
+	key.RFingerprint = "rfp1"
+	st := newTestStorage(key)
+	p, dir := newTestPKSSync(t, st, srv.Addr())
+	defer os.RemoveAll(dir)
+
+	err := p.keyChanged(storage.KeyAdded{Digest: "digest1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.sendPending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := p.spool.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("got %d queued mails after failure, want 1", len(names))
+	}
+	var job pksJob
+	err = p.spool.Get(names[0], &job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Attempts != 1 || job.LastError == "" || !job.NextAttempt.After(time.Now()) {
+		t.Errorf("job not rescheduled: %+v", job)
+	}
+
+	// The job is not retried before it is due.
+	err = p.sendPending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.spool.Get(names[0], &job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Attempts != 1 {
+		t.Errorf("job retried early: %+v", job)
+	}
+}
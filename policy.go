@@ -0,0 +1,199 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// ErrKeyRejected is the cause of errors resulting from submitting a key which
+// is refused by the ingestion policy.
+var ErrKeyRejected = errgo.New("key rejected by policy")
+
+// policyRefreshInterval is how often the policy file is checked for changes.
+const policyRefreshInterval = 5 * time.Second
+
+// PolicyRule is a rule in the policy file. A key matching any of the rule's
+// criteria is rejected.
+type PolicyRule struct {
+	Name         string   `toml:"name"`
+	Fingerprints []string `toml:"fingerprints"`
+	KeyIDs       []string `toml:"keyIDs"`
+	Domains      []string `toml:"domains"`
+
+	// UIDs are regular expressions matched against each of the key's user
+	// IDs.
+	UIDs []string `toml:"uids"`
+}
+
+type policyRule struct {
+	name string
+	keys *keyFilter
+	uids []*regexp.Regexp
+}
+
+func (r *policyRule) Match(key *openpgp.PrimaryKey) bool {
+	if !r.keys.empty() && (r.keys.matchFingerprint(key.Fingerprint()) || r.keys.matchDomains(key)) {
+		return true
+	}
+	for _, uid := range key.UserIDs {
+		for _, re := range r.uids {
+			if re.MatchString(uid.Keywords) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadPolicy reads and compiles the rules in a policy file, which is a TOML
+// document with a [[rule]] table for each rule.
+func loadPolicy(path string) ([]*policyRule, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var doc struct {
+		Rules []PolicyRule `toml:"rule"`
+	}
+	md, err := toml.Decode(string(buf), &doc)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return nil, errgo.Newf("unknown key %q", undecoded[0].String())
+	}
+	var rules []*policyRule
+	names := make(map[string]bool)
+	for i, rule := range doc.Rules {
+		if rule.Name == "" {
+			return nil, errgo.Newf("rule %d: missing name", i+1)
+		}
+		if names[rule.Name] {
+			return nil, errgo.Newf("rule %q: duplicate name", rule.Name)
+		}
+		names[rule.Name] = true
+		r := &policyRule{
+			name: rule.Name,
+			keys: newKeyFilter(rule.Fingerprints, rule.KeyIDs, rule.Domains),
+		}
+		for _, expr := range rule.UIDs {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, errgo.Notef(err, "rule %q", rule.Name)
+			}
+			r.uids = append(r.uids, re)
+		}
+		if r.keys.empty() && len(r.uids) == 0 {
+			return nil, errgo.Newf("rule %q: no fingerprints, keyIDs, domains or uids", rule.Name)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// PolicyRuleStat counts the keys rejected by a policy rule since the server
+// started.
+type PolicyRuleStat struct {
+	Rule     string `json:"rule"`
+	Rejected int    `json:"rejected"`
+}
+
+// Policy rejects keys matching the rules in a policy file. The file is
+// reloaded when it changes; if it becomes invalid, the last valid rules
+// remain in effect.
+type Policy struct {
+	path string
+
+	mu        sync.Mutex
+	rules     []*policyRule
+	modTime   time.Time
+	size      int64
+	refreshed time.Time
+	rejected  map[string]int
+}
+
+// OpenPolicy loads the policy file at path.
+func OpenPolicy(path string) (*Policy, error) {
+	p := &Policy{
+		path:     path,
+		rejected: make(map[string]int),
+	}
+	err := p.load()
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot load policy %q", path)
+	}
+	return p, nil
+}
+
+// load reads the policy file if it has changed since it was last read. It
+// must be called with p.mu held, or before p is in use.
+func (p *Policy) load() error {
+	p.refreshed = time.Now()
+	fi, err := os.Stat(p.path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if fi.ModTime().Equal(p.modTime) && fi.Size() == p.size {
+		return nil
+	}
+	rules, err := loadPolicy(p.path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	p.rules, p.modTime, p.size = rules, fi.ModTime(), fi.Size()
+	log.Infof("loaded %d policy rules from %q", len(rules), p.path)
+	return nil
+}
+
+// refresh picks up changes to the policy file. It must be called with p.mu
+// held.
+func (p *Policy) refresh() {
+	if time.Since(p.refreshed) < policyRefreshInterval {
+		return
+	}
+	err := p.load()
+	if err != nil {
+		log.Errorf("failed to reload policy %q, keeping previous rules: %v", p.path, err)
+	}
+}
+
+// Check rejects keys matching any of the policy's rules.
+func (p *Policy) Check(key *openpgp.PrimaryKey, source string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refresh()
+	for _, rule := range p.rules {
+		if !rule.Match(key) {
+			continue
+		}
+		p.rejected[rule.name]++
+		log.WithFields(log.Fields{
+			"fingerprint": key.Fingerprint(),
+			"rule":        rule.name,
+			"source":      source,
+		}).Warning("key rejected by policy")
+		return errgo.WithCausef(nil, ErrKeyRejected, "key %s rejected by policy rule %q", key.Fingerprint(), rule.name)
+	}
+	return nil
+}
+
+// Stats returns the number of keys rejected by each of the current rules, in
+// the order in which they appear in the policy file.
+func (p *Policy) Stats() []PolicyRuleStat {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refresh()
+	var result []PolicyRuleStat
+	for _, rule := range p.rules {
+		result = append(result, PolicyRuleStat{Rule: rule.name, Rejected: p.rejected[rule.name]})
+	}
+	return result
+}
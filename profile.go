@@ -0,0 +1,124 @@
+package server
+
+import (
+	"net/http"
+	httppprof "net/http/pprof"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+// ErrProfiling is the cause of errors resulting from starting a CPU profile
+// while one is already running, or stopping one that is not.
+var ErrProfiling = errgo.New("profiling error")
+
+// cpuProfile is the CPU profile being written, if any. Only one CPU profile
+// may run in a process at a time.
+var cpuProfile struct {
+	mu      sync.Mutex
+	f       *os.File
+	path    string
+	started time.Time
+}
+
+// StartCPUProfile starts writing a CPU profile, which is moved to path when
+// it is stopped.
+func StartCPUProfile(path string) error {
+	cpuProfile.mu.Lock()
+	defer cpuProfile.mu.Unlock()
+	if cpuProfile.f != nil {
+		return errgo.WithCausef(nil, ErrProfiling, "CPU profile already being written to %q", cpuProfile.path)
+	}
+	f, err := os.Create(path + ".part")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = pprof.StartCPUProfile(f)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return errgo.WithCausef(err, ErrProfiling, "cannot start CPU profile")
+	}
+	cpuProfile.f, cpuProfile.path, cpuProfile.started = f, path, time.Now()
+	log.Infof("CPU profiling started")
+	return nil
+}
+
+// StopCPUProfile stops the running CPU profile, returning the path it was
+// written to.
+func StopCPUProfile() (string, error) {
+	cpuProfile.mu.Lock()
+	defer cpuProfile.mu.Unlock()
+	if cpuProfile.f == nil {
+		return "", errgo.WithCausef(nil, ErrProfiling, "no CPU profile running")
+	}
+	pprof.StopCPUProfile()
+	f, path := cpuProfile.f, cpuProfile.path
+	cpuProfile.f, cpuProfile.path = nil, ""
+	err := f.Close()
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	log.Infof("CPU profile written to %q", path)
+	return path, nil
+}
+
+// CPUProfiling returns the path and start time of the running CPU profile,
+// if any.
+func CPUProfiling() (path string, started time.Time, ok bool) {
+	cpuProfile.mu.Lock()
+	defer cpuProfile.mu.Unlock()
+	return cpuProfile.path, cpuProfile.started, cpuProfile.f != nil
+}
+
+// WriteHeapProfile writes a heap profile to path.
+func WriteHeapProfile(path string) error {
+	f, err := os.Create(path + ".part")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = pprof.WriteHeapProfile(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return errgo.Notef(err, "failed to write heap profile")
+	}
+	err = os.Rename(f.Name(), path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("Heap profile written to %q", path)
+	return nil
+}
+
+// PprofHandler returns a handler serving the runtime profiles under
+// /debug/pprof/, as provided by net/http/pprof. CPU profiles and execution
+// traces are taken on demand for the number of seconds given in the
+// request.
+func PprofHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", httppprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+	return mux
+}
+
+// SetProfileRates enables block and mutex profiling. A rate or fraction of
+// zero leaves the profile disabled.
+func SetProfileRates(blockProfileRate, mutexProfileFraction int) {
+	runtime.SetBlockProfileRate(blockProfileRate)
+	runtime.SetMutexProfileFraction(mutexProfileFraction)
+}
@@ -0,0 +1,423 @@
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/conflux.v2/recon"
+	"gopkg.in/hockeypuck/hkp.v1/sks"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+var (
+	ErrPartnerNotFound = errgo.New("partner not found")
+	ErrPartnerExists   = errgo.New("partner already exists")
+	ErrPartnerPaused   = errgo.New("partner paused")
+)
+
+// partnerOverrides are the changes made to the configured recon partners at
+// runtime, persisted so that they survive restarts.
+type partnerOverrides struct {
+	Added   recon.PartnerMap `json:"added,omitempty"`
+	Removed []string         `json:"removed,omitempty"`
+	Paused  []string         `json:"paused,omitempty"`
+}
+
+// partnerInfo describes a recon partner in the live partner set.
+type partnerInfo struct {
+	Name      string `json:"name"`
+	HTTPAddr  string `json:"httpAddr"`
+	ReconAddr string `json:"reconAddr"`
+
+	// Configured is set if the partner comes from the config file, rather
+	// than having been added at runtime.
+	Configured bool `json:"configured"`
+	Paused     bool `json:"paused"`
+}
+
+// reconPeer runs the sks recon peer with a partner set which may be changed
+// at runtime. The sks peer takes its partners from its settings when it is
+// created, so it is replaced by a new peer whenever they change.
+type reconPeer struct {
+	st        storage.Storage
+	path      string
+	settings  *recon.Settings
+	stateFile string
+
+	mu        sync.Mutex
+	peer      *sks.Peer
+	gate      *int32
+	running   bool
+	overrides partnerOverrides
+	only      string
+	onlyTimer *time.Timer
+}
+
+func newReconPeer(st storage.Storage, settings *reconConfig) (*reconPeer, error) {
+	p := &reconPeer{
+		st:        st,
+		path:      settings.LevelDB.Path,
+		settings:  &settings.Settings,
+		stateFile: settings.PartnerStateFile,
+	}
+	err := p.load()
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot load partner state %q", p.stateFile)
+	}
+	_, err = p.peerSettings()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return p, nil
+}
+
+func (p *reconPeer) load() error {
+	if p.stateFile == "" {
+		return nil
+	}
+	buf, err := ioutil.ReadFile(p.stateFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(json.Unmarshal(buf, &p.overrides))
+}
+
+func (p *reconPeer) save() error {
+	if p.stateFile == "" {
+		return nil
+	}
+	buf, err := json.MarshalIndent(&p.overrides, "", "  ")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ioutil.WriteFile(p.stateFile+".tmp", buf, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(os.Rename(p.stateFile+".tmp", p.stateFile))
+}
+
+func contains(items []string, item string) bool {
+	for _, s := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
+func without(items []string, item string) []string {
+	var result []string
+	for _, s := range items {
+		if s != item {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// partners returns the live partner set, including paused partners. It
+// must be called with p.mu held.
+func (p *reconPeer) partners() recon.PartnerMap {
+	partners := make(recon.PartnerMap)
+	for name, partner := range p.settings.Partners {
+		if !contains(p.overrides.Removed, name) {
+			partners[name] = partner
+		}
+	}
+	for name, partner := range p.overrides.Added {
+		partners[name] = partner
+	}
+	return partners
+}
+
+// Partners returns the live partner set, including paused partners.
+func (p *reconPeer) Partners() recon.PartnerMap {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.partners()
+}
+
+// List describes the live partner set, ordered by name.
+func (p *reconPeer) List() []partnerInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var result []partnerInfo
+	for name, partner := range p.partners() {
+		_, configured := p.settings.Partners[name]
+		result = append(result, partnerInfo{
+			Name:       name,
+			HTTPAddr:   partner.HTTPAddr,
+			ReconAddr:  partner.ReconAddr,
+			Configured: configured,
+			Paused:     contains(p.overrides.Paused, name),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// peerSettings returns the recon settings for an sks peer which reconciles
+// with the active partners. It must be called with p.mu held, or before the
+// reconPeer is in use.
+func (p *reconPeer) peerSettings() (*recon.Settings, error) {
+	settings := *p.settings
+	settings.Partners = make(recon.PartnerMap)
+	for name, partner := range p.partners() {
+		if contains(p.overrides.Paused, name) {
+			continue
+		}
+		if p.only != "" && name != p.only {
+			continue
+		}
+		settings.Partners[name] = partner
+	}
+	err := settings.Resolve()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return &settings, nil
+}
+
+// newPeer creates and starts an sks peer which reconciles with the active
+// partners. It must be called with p.mu held.
+//
+// An sks peer holds the prefix tree open from when it is created, and can
+// only be stopped once started, so peers are only created to be started
+// straight away.
+func (p *reconPeer) newPeer() error {
+	settings, err := p.peerSettings()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	gate := new(int32)
+	peer, err := sks.NewPeer(&gatedStorage{Storage: p.st, closed: gate}, p.path, settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	peer.Start()
+	p.peer, p.gate = peer, gate
+	return nil
+}
+
+// stopPeer stops the running sks peer, closing its prefix tree. It must be
+// called with p.mu held.
+func (p *reconPeer) stopPeer() error {
+	p.running = false
+	atomic.StoreInt32(p.gate, 1)
+	return errgo.Mask(p.peer.Stop())
+}
+
+// restart replaces the running sks peer with one for the current partner
+// set. A peer which is not running is started with the current partner set
+// when it is. It must be called with p.mu held.
+func (p *reconPeer) restart() error {
+	if !p.running {
+		return nil
+	}
+	err := p.stopPeer()
+	if err != nil {
+		log.Errorf("failed to stop recon peer: %v", err)
+	}
+	err = p.newPeer()
+	if err != nil {
+		return errgo.Notef(err, "cannot restart recon peer")
+	}
+	p.running = true
+	return nil
+}
+
+func (p *reconPeer) Start() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running {
+		return nil
+	}
+	err := p.newPeer()
+	if err != nil {
+		return errgo.Notef(err, "cannot start recon peer")
+	}
+	p.running = true
+	return nil
+}
+
+func (p *reconPeer) Stop() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.onlyTimer != nil {
+		p.onlyTimer.Stop()
+	}
+	if !p.running {
+		return nil
+	}
+	return errgo.Mask(p.stopPeer())
+}
+
+// Stats returns the key change statistics kept by the sks peer, or those it
+// last saved if it has not been started.
+func (p *reconPeer) Stats() *sks.Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.peer != nil {
+		return p.peer.Stats()
+	}
+	stats := sks.NewStats()
+	statsFilename := sks.StatsFilename(p.path)
+	err := stats.ReadFile(statsFilename)
+	if err != nil {
+		log.Warningf("failed to open stats file %q: %v", statsFilename, err)
+		return sks.NewStats()
+	}
+	return stats
+}
+
+// update applies a change to the partner overrides, then persists them and
+// restarts the peer.
+func (p *reconPeer) update(f func() error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := f()
+	if err != nil {
+		return errgo.Mask(err, errgo.Any)
+	}
+	err = p.save()
+	if err != nil {
+		return errgo.Notef(err, "cannot save partner state %q", p.stateFile)
+	}
+	return errgo.Mask(p.restart())
+}
+
+// Add adds a partner.
+func (p *reconPeer) Add(name string, partner recon.Partner) error {
+	if name == "" {
+		return errgo.New("missing partner name")
+	}
+	for _, addr := range []string{partner.HTTPAddr, partner.ReconAddr} {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return errgo.Notef(err, "invalid address %q", addr)
+		}
+	}
+	return p.update(func() error {
+		if _, ok := p.partners()[name]; ok {
+			return errgo.WithCausef(nil, ErrPartnerExists, "partner %q already exists", name)
+		}
+		if _, ok := p.settings.Partners[name]; ok {
+			// Re-adding a removed configured partner.
+			p.overrides.Removed = without(p.overrides.Removed, name)
+			if p.settings.Partners[name] == partner {
+				return nil
+			}
+		}
+		if p.overrides.Added == nil {
+			p.overrides.Added = make(recon.PartnerMap)
+		}
+		p.overrides.Added[name] = partner
+		return nil
+	})
+}
+
+// Remove removes a partner.
+func (p *reconPeer) Remove(name string) error {
+	return p.update(func() error {
+		if _, ok := p.partners()[name]; !ok {
+			return errgo.WithCausef(nil, ErrPartnerNotFound, "partner %q not found", name)
+		}
+		delete(p.overrides.Added, name)
+		if _, ok := p.settings.Partners[name]; ok && !contains(p.overrides.Removed, name) {
+			p.overrides.Removed = append(p.overrides.Removed, name)
+		}
+		p.overrides.Paused = without(p.overrides.Paused, name)
+		return nil
+	})
+}
+
+// Pause stops reconciling with a partner until it is resumed.
+func (p *reconPeer) Pause(name string) error {
+	return p.update(func() error {
+		if _, ok := p.partners()[name]; !ok {
+			return errgo.WithCausef(nil, ErrPartnerNotFound, "partner %q not found", name)
+		}
+		if !contains(p.overrides.Paused, name) {
+			p.overrides.Paused = append(p.overrides.Paused, name)
+		}
+		return nil
+	})
+}
+
+// Resume resumes reconciling with a paused partner.
+func (p *reconPeer) Resume(name string) error {
+	return p.update(func() error {
+		if _, ok := p.partners()[name]; !ok {
+			return errgo.WithCausef(nil, ErrPartnerNotFound, "partner %q not found", name)
+		}
+		p.overrides.Paused = without(p.overrides.Paused, name)
+		return nil
+	})
+}
+
+// ReconNow arranges for the next recon sessions to be with the named
+// partner. The peer gossips with only that partner for two gossip intervals,
+// after which the full partner set is restored. This is not persisted.
+func (p *reconPeer) ReconNow(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.partners()[name]; !ok {
+		return errgo.WithCausef(nil, ErrPartnerNotFound, "partner %q not found", name)
+	}
+	if contains(p.overrides.Paused, name) {
+		return errgo.WithCausef(nil, ErrPartnerPaused, "partner %q is paused", name)
+	}
+	if p.onlyTimer != nil {
+		p.onlyTimer.Stop()
+	}
+	p.only = name
+	err := p.restart()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	interval := time.Duration(p.settings.GossipIntervalSecs) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	p.onlyTimer = time.AfterFunc(2*interval, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.only != name || !p.running {
+			return
+		}
+		p.only = ""
+		err := p.restart()
+		if err != nil {
+			log.Errorf("failed to restore recon partners: %v", err)
+		}
+	})
+	return nil
+}
+
+// gatedStorage stops notifying a replaced sks peer of key changes. Storage
+// has no way to unsubscribe, so the subscriptions of replaced peers remain
+// but are closed.
+type gatedStorage struct {
+	storage.Storage
+	closed *int32
+}
+
+func (st *gatedStorage) Subscribe(f func(storage.KeyChange) error) {
+	closed := st.closed
+	st.Storage.Subscribe(func(kc storage.KeyChange) error {
+		if atomic.LoadInt32(closed) != 0 {
+			return nil
+		}
+		return f(kc)
+	})
+}
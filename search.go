@@ -0,0 +1,327 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/crypto/openpgp/packet"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// searchSchemaVersion is the version of the JSON search API's response
+// schema. It is incremented when fields are removed or change meaning;
+// fields may be added without changing it.
+const searchSchemaVersion = 1
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// Signature types indicating revocation.
+const (
+	sigTypeKeyRevocation           = 0x20
+	sigTypeSubkeyRevocation        = 0x28
+	sigTypeCertificationRevocation = 0x30
+)
+
+// Verification states of user IDs in search results.
+const (
+	// searchVerificationPublished user IDs have had their email address
+	// verified.
+	searchVerificationPublished = "published"
+
+	// searchVerificationUnverified user IDs are served without
+	// verification, as it is not enabled.
+	searchVerificationUnverified = "unverified"
+)
+
+// publicKeyAlgorithms names the OpenPGP public key algorithms.
+var publicKeyAlgorithms = map[int]string{
+	1:  "rsa",
+	2:  "rsa-encrypt",
+	3:  "rsa-sign",
+	16: "elgamal",
+	17: "dsa",
+	18: "ecdh",
+	19: "ecdsa",
+	22: "eddsa",
+}
+
+// searchResponse is the JSON body of a search API response.
+type searchResponse struct {
+	Version int         `json:"version"`
+	Query   string      `json:"query"`
+	Total   int         `json:"total"`
+	Offset  int         `json:"offset"`
+	Limit   int         `json:"limit"`
+	Keys    []searchKey `json:"keys"`
+}
+
+type searchKey struct {
+	Fingerprint   string         `json:"fingerprint"`
+	KeyID         string         `json:"keyID"`
+	Digest        string         `json:"digest"`
+	Algorithm     int            `json:"algorithm"`
+	AlgorithmName string         `json:"algorithmName"`
+	BitLength     int            `json:"bitLength"`
+	Created       time.Time      `json:"created"`
+	Expires       *time.Time     `json:"expires,omitempty"`
+	Revoked       bool           `json:"revoked"`
+	Usage         []string       `json:"usage"`
+	UserIDs       []searchUserID `json:"userIDs"`
+	Subkeys       []searchSubkey `json:"subkeys"`
+}
+
+type searchUserID struct {
+	UserID       string `json:"uid"`
+	Email        string `json:"email,omitempty"`
+	Primary      bool   `json:"primary"`
+	Revoked      bool   `json:"revoked"`
+	Verification string `json:"verification"`
+}
+
+type searchSubkey struct {
+	Fingerprint   string     `json:"fingerprint"`
+	KeyID         string     `json:"keyID"`
+	Algorithm     int        `json:"algorithm"`
+	AlgorithmName string     `json:"algorithmName"`
+	BitLength     int        `json:"bitLength"`
+	Created       time.Time  `json:"created"`
+	Expires       *time.Time `json:"expires,omitempty"`
+	Revoked       bool       `json:"revoked"`
+	Usage         []string   `json:"usage"`
+}
+
+func expiry(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+// selfSigned returns whether any of the signatures made by key among sigs
+// has the given type.
+func selfSigned(key *openpgp.PrimaryKey, sigs []*openpgp.Signature, sigType int) bool {
+	for _, sig := range selfSignatures(key, sigs) {
+		if sig.SigType == sigType {
+			return true
+		}
+	}
+	return false
+}
+
+// keyUsage returns the usage flags of the most recent of the self-signatures
+// among sigs which has them.
+func keyUsage(key *openpgp.PrimaryKey, sigs []*openpgp.Signature) []string {
+	var newest *packet.Signature
+	var newestTime time.Time
+	for _, sig := range selfSignatures(key, sigs) {
+		if newest != nil && !sig.Creation.After(newestTime) {
+			continue
+		}
+		p, err := packet.Read(bytes.NewReader(sig.Packet.Packet))
+		if err != nil {
+			continue
+		}
+		if s, ok := p.(*packet.Signature); ok && s.FlagsValid {
+			newest, newestTime = s, sig.Creation
+		}
+	}
+	usage := []string{}
+	if newest == nil {
+		return usage
+	}
+	if newest.FlagCertify {
+		usage = append(usage, "certify")
+	}
+	if newest.FlagSign {
+		usage = append(usage, "sign")
+	}
+	if newest.FlagEncryptCommunications {
+		usage = append(usage, "encrypt-communications")
+	}
+	if newest.FlagEncryptStorage {
+		usage = append(usage, "encrypt-storage")
+	}
+	return usage
+}
+
+// newSearchKey describes key for the search API. verified is whether the
+// user IDs of key have been filtered by email verification.
+func newSearchKey(key *openpgp.PrimaryKey, verified bool) searchKey {
+	result := searchKey{
+		Fingerprint:   key.Fingerprint(),
+		KeyID:         key.KeyID(),
+		Digest:        key.MD5,
+		Algorithm:     key.Algorithm,
+		AlgorithmName: publicKeyAlgorithms[key.Algorithm],
+		BitLength:     key.BitLen,
+		Created:       key.Creation.UTC(),
+		Expires:       expiry(key.Expiration),
+		Revoked:       selfSigned(key, key.Signatures, sigTypeKeyRevocation),
+		UserIDs:       []searchUserID{},
+		Subkeys:       []searchSubkey{},
+	}
+	// The primary key's usage is given by a direct key signature, or
+	// otherwise by the user ID self-signatures.
+	primarySigs := append([]*openpgp.Signature(nil), key.Signatures...)
+	verification := searchVerificationUnverified
+	if verified {
+		verification = searchVerificationPublished
+	}
+	for _, uid := range key.UserIDs {
+		result.UserIDs = append(result.UserIDs, searchUserID{
+			UserID:       uid.Keywords,
+			Email:        uidEmail(uid.Keywords),
+			Primary:      uidPrimary(key, uid),
+			Revoked:      selfSigned(key, uid.Signatures, sigTypeCertificationRevocation),
+			Verification: verification,
+		})
+		primarySigs = append(primarySigs, uid.Signatures...)
+	}
+	result.Usage = keyUsage(key, primarySigs)
+	for _, subkey := range key.SubKeys {
+		result.Subkeys = append(result.Subkeys, searchSubkey{
+			Fingerprint:   subkey.Fingerprint(),
+			KeyID:         subkey.KeyID(),
+			Algorithm:     subkey.Algorithm,
+			AlgorithmName: publicKeyAlgorithms[subkey.Algorithm],
+			BitLength:     subkey.BitLen,
+			Created:       subkey.Creation.UTC(),
+			Expires:       expiry(subkey.Expiration),
+			Revoked:       selfSigned(key, subkey.Signatures, sigTypeSubkeyRevocation),
+			Usage:         keyUsage(key, subkey.Signatures),
+		})
+	}
+	return result
+}
+
+// uidPrimary returns whether the most recent self-signature on uid marks it
+// as the primary user ID.
+func uidPrimary(key *openpgp.PrimaryKey, uid *openpgp.UserID) bool {
+	var newest *openpgp.Signature
+	for _, sig := range selfSignatures(key, uid.Signatures) {
+		if newest == nil || sig.Creation.After(newest.Creation) {
+			newest = sig
+		}
+	}
+	return newest != nil && newest.Primary
+}
+
+// search serves the JSON search API at /pks/search. The q parameter is a
+// key ID or fingerprint with a 0x prefix, or otherwise keywords matched
+// against user IDs. Results are paginated by the offset and limit
+// parameters.
+type search struct {
+	lookups storage.Storage
+
+	// verified is whether lookups only has user IDs whose email addresses
+	// have been verified.
+	verified bool
+}
+
+func searchFail(w http.ResponseWriter, status int, err error) {
+	if status == http.StatusInternalServerError {
+		log.Errorf("search: %v", errgo.Details(err))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+func searchInt(req *http.Request, name string, def int) (int, error) {
+	s := req.URL.Query().Get(name)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, errgo.Newf("invalid %s %q", name, s)
+	}
+	return n, nil
+}
+
+func (h *search) serve(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	q := strings.TrimSpace(req.URL.Query().Get("q"))
+	if q == "" {
+		searchFail(w, http.StatusBadRequest, errgo.New("missing q"))
+		return
+	}
+	offset, err := searchInt(req, "offset", 0)
+	if err != nil {
+		searchFail(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := searchInt(req, "limit", defaultSearchLimit)
+	if err != nil {
+		searchFail(w, http.StatusBadRequest, err)
+		return
+	}
+	if limit == 0 || limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	var rfps []string
+	if strings.HasPrefix(strings.ToLower(q), "0x") {
+		keyID := normalizeKeyID(q)
+		if _, err := hex.DecodeString(keyID); err != nil || len(keyID) < 8 {
+			searchFail(w, http.StatusBadRequest, errgo.Newf("invalid key ID %q", q))
+			return
+		}
+		rfps, err = h.lookups.Resolve([]string{ReverseString(keyID)})
+	} else {
+		rfps, err = h.lookups.MatchKeyword([]string{q})
+	}
+	if err != nil && !storage.IsNotFound(err) {
+		searchFail(w, http.StatusInternalServerError, err)
+		return
+	}
+	// Results are ordered by fingerprint, so that pages are stable.
+	sort.Strings(rfps)
+
+	resp := &searchResponse{
+		Version: searchSchemaVersion,
+		Query:   q,
+		Total:   len(rfps),
+		Offset:  offset,
+		Limit:   limit,
+		Keys:    []searchKey{},
+	}
+	if offset < len(rfps) {
+		page := rfps[offset:]
+		if len(page) > limit {
+			page = page[:limit]
+		}
+		keys, err := h.lookups.FetchKeys(page)
+		if err != nil && !storage.IsNotFound(err) {
+			searchFail(w, http.StatusInternalServerError, err)
+			return
+		}
+		byRfp := make(map[string]*openpgp.PrimaryKey)
+		for _, key := range keys {
+			byRfp[key.RFingerprint] = key
+		}
+		for _, rfp := range page {
+			if key, ok := byRfp[rfp]; ok {
+				resp.Keys = append(resp.Keys, newSearchKey(key, h.verified))
+			}
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		log.Errorf("failed to write search response: %v", err)
+	}
+}
@@ -0,0 +1,687 @@
+package server
+
+import (
+	"encoding/gob"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	// searchIndexVersion is the version of the format of saved search
+	// indexes. Indexes saved in another format are rebuilt.
+	searchIndexVersion = 1
+
+	// searchIndexChunkSize is the number of keys fetched from storage at a
+	// time while building the search index.
+	searchIndexChunkSize = 100
+
+	// searchIndexQueueSize is the number of changed keys which may be
+	// awaiting indexing.
+	searchIndexQueueSize = 1000
+
+	// searchIndexSaveInterval is how often the search index is saved, if it
+	// has changed.
+	searchIndexSaveInterval = 10 * time.Minute
+
+	// searchMinPrefix is the shortest term which may be searched for as a
+	// prefix, so that a query cannot match the entire vocabulary.
+	searchMinPrefix = 2
+
+	// searchMaxFuzziness is the greatest edit distance a fuzzy term may be
+	// searched for with.
+	searchMaxFuzziness = 2
+)
+
+// Fields of user IDs which queries may be scoped to. searchFieldAny holds the
+// terms of every field, and is searched by unscoped queries.
+const (
+	searchFieldAny     = ""
+	searchFieldName    = "name"
+	searchFieldEmail   = "email"
+	searchFieldDomain  = "domain"
+	searchFieldComment = "comment"
+)
+
+var searchFields = map[string]bool{
+	searchFieldName:    true,
+	searchFieldEmail:   true,
+	searchFieldDomain:  true,
+	searchFieldComment: true,
+}
+
+// searchTokens splits s into lower-cased words.
+func searchTokens(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// userIDTerms returns the terms of each field of a user ID of the form
+// "Name (Comment) <email>". The email field has the whole address and its
+// local part, and the domain field the domain of the address and each of its
+// parent domains.
+func userIDTerms(keywords string) map[string][]string {
+	terms := make(map[string][]string)
+	add := func(field string, values ...string) {
+		terms[field] = append(terms[field], values...)
+		if field != searchFieldAny {
+			terms[searchFieldAny] = append(terms[searchFieldAny], values...)
+		}
+	}
+	name := keywords
+	if i := strings.Index(name, "<"); i >= 0 {
+		name = name[:i]
+	}
+	if start, end := strings.Index(name, "("), strings.LastIndex(name, ")"); start >= 0 && end > start {
+		add(searchFieldComment, searchTokens(name[start+1:end])...)
+		name = name[:start] + name[end+1:]
+	}
+	add(searchFieldName, searchTokens(name)...)
+	if email := uidEmail(keywords); email != "" {
+		local, domain := splitEmail(email)
+		add(searchFieldEmail, email, local)
+		add(searchFieldAny, searchTokens(local)...)
+		for domain != "" {
+			add(searchFieldDomain, domain)
+			i := strings.Index(domain, ".")
+			if i < 0 || !strings.Contains(domain[i+1:], ".") {
+				break
+			}
+			domain = domain[i+1:]
+		}
+		add(searchFieldAny, searchTokens(emailDomain(email))...)
+	}
+	return terms
+}
+
+// searchTerm is a term of a query. Matching keys have a term in field equal
+// to value, or starting with it if prefix is set, or within an edit distance
+// of fuzziness of it.
+type searchTerm struct {
+	field     string
+	value     string
+	prefix    bool
+	fuzziness int
+}
+
+// parseSearchQuery parses a query of whitespace-separated terms, all of which
+// must match. A term may be scoped to a field as name:, email:, domain: or
+// comment:, and may end in * to match as a prefix, or in ~ followed by an
+// optional edit distance to match fuzzily.
+func parseSearchQuery(search []string) []searchTerm {
+	var terms []searchTerm
+	for _, word := range strings.Fields(strings.Join(search, " ")) {
+		term := searchTerm{field: searchFieldAny}
+		if i := strings.Index(word, ":"); i > 0 && searchFields[strings.ToLower(word[:i])] {
+			term.field, word = strings.ToLower(word[:i]), word[i+1:]
+		}
+		if strings.HasSuffix(word, "*") {
+			word = strings.TrimRight(word, "*")
+			term.prefix = true
+		} else if i := strings.LastIndex(word, "~"); i >= 0 {
+			term.fuzziness = 1
+			if n, err := strconv.Atoi(word[i+1:]); err == nil && n >= 0 {
+				term.fuzziness = n
+			}
+			if term.fuzziness > searchMaxFuzziness {
+				term.fuzziness = searchMaxFuzziness
+			}
+			word = word[:i]
+		}
+		var values []string
+		switch {
+		case term.field == searchFieldEmail || term.field == searchFieldDomain:
+			values = []string{strings.ToLower(word)}
+		case term.field == searchFieldAny && strings.ContainsAny(word, "@."):
+			values = []string{strings.ToLower(word)}
+		default:
+			values = searchTokens(word)
+		}
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			t := term
+			t.value = value
+			if t.prefix && len(value) < searchMinPrefix {
+				t.prefix = false
+			}
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// matches returns whether term matches the indexed term s.
+func (term *searchTerm) matches(s string) bool {
+	switch {
+	case term.prefix:
+		return strings.HasPrefix(s, term.value)
+	case term.fuzziness > 0:
+		return editDistance(term.value, s, term.fuzziness) <= term.fuzziness
+	}
+	return s == term.value
+}
+
+// editDistance returns the Levenshtein distance between a and b, or max+1 if
+// it exceeds max.
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > max || -d > max {
+		return max + 1
+	}
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if prev[j]+1 < cur[j] {
+				cur[j] = prev[j] + 1
+			}
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// savedSearchIndex is the content of a saved search index: the user IDs of
+// each key, by reversed fingerprint. The terms are derived from them when
+// the index is loaded.
+type savedSearchIndex struct {
+	Version int
+	Saved   time.Time
+	UserIDs map[string][]string
+}
+
+// SearchIndex is an index of the user IDs of the keys in storage, which
+// answers keyword searches with prefix, fuzzy and field-scoped queries in
+// place of the storage driver.
+//
+// The index is loaded from its file when the server starts, or built from
+// storage in the background if there is none, and kept up to date as keys
+// change. It is saved periodically and when the server stops. Keys changed
+// while the server is not running, such as by the load command, are only
+// indexed once the index is rebuilt with the reindex command. Until the
+// index is ready, searches are answered by the storage driver.
+type SearchIndex struct {
+	path    string
+	st      storage.Storage
+	backend KeyBackend
+	changed chan string
+
+	mu      sync.RWMutex
+	userIDs map[string][]string
+	terms   map[string]map[string]map[string]bool
+	vocab   map[string][]string
+	ready   bool
+	dirty   bool
+
+	t tomb.Tomb
+}
+
+func newSearchIndex(settings *Settings, st storage.Storage, backend KeyBackend) *SearchIndex {
+	return &SearchIndex{
+		path:    settings.Search.Path,
+		st:      st,
+		backend: backend,
+		changed: make(chan string, searchIndexQueueSize),
+		userIDs: make(map[string][]string),
+		terms:   make(map[string]map[string]map[string]bool),
+		vocab:   make(map[string][]string),
+	}
+}
+
+// NewSearchIndex returns the search index of the keys in st, which is
+// updated as they change once it has been started. backend lists the keys
+// when the index is built.
+func NewSearchIndex(settings *Settings, st storage.Storage, backend KeyBackend) *SearchIndex {
+	idx := newSearchIndex(settings, st, backend)
+	st.Subscribe(idx.keyChanged)
+	return idx
+}
+
+// RebuildSearchIndex builds the search index afresh from the keys in st,
+// replacing the saved index. The server must not be running, as it would
+// overwrite the rebuilt index with its own when it stops.
+func RebuildSearchIndex(settings *Settings, st storage.Storage) error {
+	if settings.Search == nil {
+		return errgo.New("search index not configured")
+	}
+	backend, err := DialKeyBackend(settings)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer backend.Close()
+	idx := newSearchIndex(settings, st, backend)
+	start := time.Now()
+	err = idx.build()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = idx.save()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	log.Infof("search index %q of %d keys rebuilt in %v", idx.path, len(idx.userIDs), time.Since(start))
+	return nil
+}
+
+func (idx *SearchIndex) Start() {
+	idx.t.Go(idx.loop)
+}
+
+func (idx *SearchIndex) Stop() error {
+	idx.t.Kill(nil)
+	return errgo.Mask(idx.t.Wait())
+}
+
+func (idx *SearchIndex) keyChanged(kc storage.KeyChange) error {
+	if kd, ok := kc.(KeyDeleted); ok {
+		idx.mu.Lock()
+		idx.unindex(ReverseString(kd.Fingerprint))
+		idx.mu.Unlock()
+		return nil
+	}
+	_, _, digest, ok := describeKeyChange(kc)
+	if !ok || digest == "" {
+		return nil
+	}
+	select {
+	case idx.changed <- digest:
+	default:
+		log.Warningf("search index queue full, not indexing key with digest %s", digest)
+	}
+	return nil
+}
+
+func (idx *SearchIndex) loop() error {
+	start := time.Now()
+	err := idx.load()
+	if os.IsNotExist(errgo.Cause(err)) {
+		log.Infof("search index %q not found, building it from storage", idx.path)
+		err = idx.build()
+	} else if err != nil {
+		log.Warningf("cannot load search index %q, building it from storage: %v", idx.path, err)
+		err = idx.build()
+	}
+	if errgo.Cause(err) == tomb.ErrDying {
+		return nil
+	} else if err != nil {
+		log.Errorf("failed to build search index: %v", err)
+	} else {
+		idx.mu.Lock()
+		idx.ready = true
+		n := len(idx.userIDs)
+		idx.mu.Unlock()
+		log.Infof("search index of %d keys ready in %v", n, time.Since(start))
+	}
+
+	ticker := time.NewTicker(searchIndexSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-idx.t.Dying():
+			return errgo.Mask(idx.saveChanges())
+		case <-ticker.C:
+			err := idx.saveChanges()
+			if err != nil {
+				log.Errorf("failed to save search index %q: %v", idx.path, err)
+			}
+		case digest := <-idx.changed:
+			err := idx.indexDigest(digest)
+			if err != nil {
+				log.Errorf("failed to index key with digest %s for search: %v", digest, err)
+			}
+		}
+	}
+}
+
+// load reads the saved index.
+func (idx *SearchIndex) load() error {
+	f, err := os.Open(idx.path)
+	if err != nil {
+		return errgo.Mask(err, os.IsNotExist)
+	}
+	defer f.Close()
+	var saved savedSearchIndex
+	err = gob.NewDecoder(f).Decode(&saved)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if saved.Version != searchIndexVersion {
+		return errgo.Newf("unsupported version %d", saved.Version)
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for rfp, uids := range saved.UserIDs {
+		idx.index(rfp, uids)
+	}
+	idx.dirty = false
+	log.Infof("search index %q saved %v loaded", idx.path, saved.Saved)
+	return nil
+}
+
+// saveChanges saves the index if it has changed since it was last saved.
+// An index which is not ready is not saved, lest a partial index replace a
+// complete one.
+func (idx *SearchIndex) saveChanges() error {
+	idx.mu.RLock()
+	save := idx.ready && idx.dirty
+	idx.mu.RUnlock()
+	if !save {
+		return nil
+	}
+	return errgo.Mask(idx.save())
+}
+
+func (idx *SearchIndex) save() error {
+	idx.mu.Lock()
+	saved := savedSearchIndex{
+		Version: searchIndexVersion,
+		Saved:   time.Now(),
+		UserIDs: make(map[string][]string, len(idx.userIDs)),
+	}
+	for rfp, uids := range idx.userIDs {
+		saved.UserIDs[rfp] = uids
+	}
+	idx.dirty = false
+	idx.mu.Unlock()
+
+	f, err := os.Create(idx.path + ".part")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = gob.NewEncoder(f).Encode(&saved)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(os.Rename(f.Name(), idx.path))
+}
+
+// build indexes every key in storage. Keys changed meanwhile are indexed
+// again afterwards from the queue.
+func (idx *SearchIndex) build() error {
+	var rfps []string
+	flush := func() error {
+		keys, err := idx.st.FetchKeys(rfps)
+		rfps = rfps[:0]
+		if err != nil && !storage.IsNotFound(err) {
+			return errgo.Mask(err)
+		}
+		idx.mu.Lock()
+		defer idx.mu.Unlock()
+		for _, key := range keys {
+			idx.indexKey(key)
+		}
+		return nil
+	}
+	err := idx.backend.Fingerprints(func(rfp string) error {
+		select {
+		case <-idx.t.Dying():
+			return tomb.ErrDying
+		default:
+		}
+		rfps = append(rfps, rfp)
+		if len(rfps) < searchIndexChunkSize {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil && len(rfps) > 0 {
+		err = flush()
+	}
+	return errgo.Mask(err, errgo.Is(tomb.ErrDying))
+}
+
+func (idx *SearchIndex) indexDigest(digest string) error {
+	rfps, err := idx.st.MatchMD5([]string{digest})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	keys, err := idx.st.FetchKeys(rfps)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, key := range keys {
+		idx.indexKey(key)
+	}
+	return nil
+}
+
+// indexKey replaces the index entries of key with those of its current user
+// IDs. It must be called with idx.mu held.
+func (idx *SearchIndex) indexKey(key *openpgp.PrimaryKey) {
+	var uids []string
+	for _, uid := range key.UserIDs {
+		uids = append(uids, uid.Keywords)
+	}
+	idx.unindex(key.RFingerprint)
+	idx.index(key.RFingerprint, uids)
+}
+
+// index adds entries for the user IDs of the key with the given reversed
+// fingerprint. It must be called with idx.mu held.
+func (idx *SearchIndex) index(rfp string, uids []string) {
+	if len(uids) == 0 {
+		return
+	}
+	idx.userIDs[rfp] = uids
+	idx.dirty = true
+	for _, uid := range uids {
+		for field, values := range userIDTerms(uid) {
+			if idx.terms[field] == nil {
+				idx.terms[field] = make(map[string]map[string]bool)
+			}
+			for _, value := range values {
+				if idx.terms[field][value] == nil {
+					idx.terms[field][value] = make(map[string]bool)
+					delete(idx.vocab, field)
+				}
+				idx.terms[field][value][rfp] = true
+			}
+		}
+	}
+}
+
+// unindex removes the entries of the key with the given reversed
+// fingerprint. It must be called with idx.mu held.
+func (idx *SearchIndex) unindex(rfp string) {
+	uids, ok := idx.userIDs[rfp]
+	if !ok {
+		return
+	}
+	delete(idx.userIDs, rfp)
+	idx.dirty = true
+	for _, uid := range uids {
+		for field, values := range userIDTerms(uid) {
+			for _, value := range values {
+				delete(idx.terms[field][value], rfp)
+				if len(idx.terms[field][value]) == 0 {
+					delete(idx.terms[field], value)
+					delete(idx.vocab, field)
+				}
+			}
+		}
+	}
+}
+
+// vocabulary returns the sorted terms of field. The slice returned is not
+// modified afterwards, and may be used without idx.mu held.
+func (idx *SearchIndex) vocabulary(field string) []string {
+	idx.mu.RLock()
+	vocab, ok := idx.vocab[field]
+	idx.mu.RUnlock()
+	if ok {
+		return vocab
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if vocab, ok := idx.vocab[field]; ok {
+		return vocab
+	}
+	vocab = make([]string, 0, len(idx.terms[field]))
+	for value := range idx.terms[field] {
+		vocab = append(vocab, value)
+	}
+	sort.Strings(vocab)
+	idx.vocab[field] = vocab
+	return vocab
+}
+
+// expand returns the indexed terms matching term.
+func (idx *SearchIndex) expand(term searchTerm) []string {
+	if !term.prefix && term.fuzziness == 0 {
+		return []string{term.value}
+	}
+	vocab := idx.vocabulary(term.field)
+	var result []string
+	if term.prefix {
+		for i := sort.SearchStrings(vocab, term.value); i < len(vocab) && strings.HasPrefix(vocab[i], term.value); i++ {
+			result = append(result, vocab[i])
+		}
+		return result
+	}
+	for _, value := range vocab {
+		if term.matches(value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// Ready returns whether the index has been loaded or built.
+func (idx *SearchIndex) Ready() bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.ready
+}
+
+// Search returns the reversed fingerprints of the keys matching every term of
+// the query in search.
+func (idx *SearchIndex) Search(search []string) []string {
+	terms := parseSearchQuery(search)
+	if len(terms) == 0 {
+		return nil
+	}
+	expanded := make([][]string, len(terms))
+	for i, term := range terms {
+		expanded[i] = idx.expand(term)
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	var matched map[string]bool
+	for i, term := range terms {
+		termMatched := make(map[string]bool)
+		for _, value := range expanded[i] {
+			for rfp := range idx.terms[term.field][value] {
+				if matched == nil || matched[rfp] {
+					termMatched[rfp] = true
+				}
+			}
+		}
+		matched = termMatched
+		if len(matched) == 0 {
+			return nil
+		}
+	}
+	result := make([]string, 0, len(matched))
+	for rfp := range matched {
+		result = append(result, rfp)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// matchUserIDs returns whether every term of the query in search matches
+// some of the given user IDs.
+func matchUserIDs(search []string, uids []string) bool {
+	terms := parseSearchQuery(search)
+	if len(terms) == 0 {
+		return false
+	}
+	uidTerms := make(map[string][]string)
+	for _, uid := range uids {
+		for field, values := range userIDTerms(uid) {
+			uidTerms[field] = append(uidTerms[field], values...)
+		}
+	}
+next:
+	for _, term := range terms {
+		for _, value := range uidTerms[term.field] {
+			if term.matches(value) {
+				continue next
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Storage returns a view of st which answers keyword searches from the
+// index once it is ready.
+func (idx *SearchIndex) Storage(st storage.Storage) storage.Storage {
+	return &indexedStorage{Storage: st, idx: idx}
+}
+
+type indexedStorage struct {
+	storage.Storage
+	idx *SearchIndex
+}
+
+func (st *indexedStorage) MatchKeyword(search []string) ([]string, error) {
+	if !st.idx.Ready() {
+		return st.Storage.MatchKeyword(search)
+	}
+	return st.idx.Search(search), nil
+}
+
+// MatchUserIDs implements userIDMatcher.
+func (st *indexedStorage) MatchUserIDs(search []string, uids []string) bool {
+	if !st.idx.Ready() {
+		return matchKeywords(search, uids)
+	}
+	return matchUserIDs(search, uids)
+}
+
+// userIDMatcher is implemented by views of storage whose keyword searches
+// are not answered by the storage driver, to determine whether a search
+// matches the given user IDs of a key.
+type userIDMatcher interface {
+	MatchUserIDs(search []string, uids []string) bool
+}
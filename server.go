@@ -6,12 +6,16 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/carbocation/interpose"
 	"github.com/julienschmidt/httprouter"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"gopkg.in/errgo.v1"
 	"gopkg.in/tomb.v2"
 
@@ -26,13 +30,34 @@ import (
 type Server struct {
 	settings  *Settings
 	st        storage.Storage
+	backend   KeyBackend
 	middle    *interpose.Middleware
 	r         *httprouter.Router
-	sksPeer   *sks.Peer
+	sksPeer   *reconPeer
+	pks       *pksSync
+	webhooks  *webhooks
+	journal   *Journal
+	sources   *sourceTracker
+	partners  *partnerTracker
+	ingestion *Ingestion
+	takedown  *Takedown
+	stripper  *Stripper
+	verifier  *Verifier
+	lookups   http.Handler
+	wkd       *wkd
+	sindex    *SearchIndex
+	cache     *lookupCache
 	logWriter io.WriteCloser
 
-	t                 tomb.Tomb
-	hkpAddr, hkpsAddr string
+	// templatesDir holds the embedded templates used in place of those
+	// not configured.
+	templatesDir string
+
+	t                            tomb.Tomb
+	hkpAddr, hkpsAddr, adminAddr string
+
+	logMu    sync.Mutex
+	logLevel string
 }
 
 func NewServer(settings *Settings) (*Server, error) {
@@ -43,13 +68,50 @@ func NewServer(settings *Settings) (*Server, error) {
 	s := &Server{
 		settings: settings,
 		r:        httprouter.New(),
+		sources:  newSourceTracker(),
 	}
+	s.partners = newPartnerTracker(func() []partnerInfo {
+		return s.sksPeer.List()
+	})
+
+	// Whatever has been opened is closed again if the server cannot be
+	// created.
+	var created bool
+	defer func() {
+		if !created {
+			s.closeResources()
+			if s.st != nil {
+				s.st.Close()
+			}
+		}
+	}()
 
 	var err error
 	s.st, err = DialStorage(settings)
 	if err != nil {
 		return nil, err
 	}
+	s.backend, err = DialKeyBackend(settings)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+
+	s.ingestion, err = OpenIngestion(settings)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	s.takedown = NewTakedown(s.sources.Storage(s.st, SourceAdmin), s.backend, s.ingestion.Tombstones)
+	s.stripper = NewStripper(s.sources.Storage(s.st, SourceAdmin), s.backend)
+	if settings.Search != nil {
+		s.sindex = NewSearchIndex(settings, s.st, s.backend)
+	}
+	if settings.OpenPGP.Verification != nil {
+		s.verifier, err = NewVerifier(settings, s.st, s.backend, s.sources.Source)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		s.verifier.Register(s.r)
+	}
 
 	s.middle = interpose.New()
 	s.middle.Use(func(next http.Handler) http.Handler {
@@ -63,39 +125,170 @@ func NewServer(settings *Settings) (*Server, error) {
 			}).Info()
 		})
 	})
+	if settings.Compression != nil {
+		s.middle.Use(newCompressor(settings.Compression).middleware)
+	}
+	s.middle.Use(s.partners.middleware)
+	if settings.Cache != nil {
+		// Responses are cached as they are finally served, after any
+		// stripping or filtering of unverified user IDs.
+		s.cache = newLookupCache(settings.Cache, s.st)
+		if s.verifier != nil {
+			s.verifier.SubscribePublications(s.cache.InvalidateFingerprint)
+		}
+		s.middle.Use(s.cache.middleware)
+	}
+	if settings.OpenPGP.StripCertifications != "" {
+		s.middle.Use(stripLookups)
+	}
+	if s.verifier != nil {
+		s.middle.Use(s.verifiedLookups)
+	}
 	s.middle.UseHandler(s.r)
 
-	s.sksPeer, err = sks.NewPeer(s.st, settings.Conflux.Recon.LevelDB.Path, &settings.Conflux.Recon.Settings)
+	s.sksPeer, err = newReconPeer(s.ingestStorage(SourceRecon), &settings.Conflux.Recon)
 	if err != nil {
 		return nil, errgo.Mask(err)
 	}
 
-	options := []hkp.HandlerOption{hkp.StatsFunc(s.stats)}
-	if settings.IndexTemplate != "" {
-		options = append(options, hkp.IndexTemplate(settings.IndexTemplate))
+	if settings.OpenPGP.PKS != nil {
+		s.pks, err = newPKSSync(s.ingestStorage(SourcePKS), settings.OpenPGP.PKS)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
 	}
-	if settings.VIndexTemplate != "" {
-		options = append(options, hkp.VIndexTemplate(settings.VIndexTemplate))
+
+	if settings.Webhooks != nil {
+		s.webhooks, err = newWebhooks(s.st, settings.Webhooks)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
 	}
-	if settings.StatsTemplate != "" {
-		options = append(options, hkp.StatsTemplate(settings.StatsTemplate))
+
+	if settings.Journal != nil {
+		s.journal, err = OpenJournal(settings.Journal)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		s.journal.Record(s.st, s.sources.Source)
+		if settings.Journal.Public {
+			s.r.GET("/pks/changes", s.serveChanges)
+			s.r.GET("/pks/journal", s.serveJournal)
+		}
+	}
+
+	assets := newAssets(settings.AssetsDir)
+	indexTemplate, vindexTemplate, statsTemplate := settings.IndexTemplate, settings.VIndexTemplate, settings.StatsTemplate
+	if indexTemplate == "" || vindexTemplate == "" || statsTemplate == "" {
+		index, stats, err := assets.extractTemplates()
+		if err != nil {
+			return nil, errgo.Notef(err, "cannot extract embedded templates")
+		}
+		s.templatesDir = filepath.Dir(index)
+		if indexTemplate == "" {
+			indexTemplate = index
+		}
+		if vindexTemplate == "" {
+			vindexTemplate = index
+		}
+		if statsTemplate == "" {
+			statsTemplate = stats
+		}
 	}
-	h, err := hkp.NewHandler(s.st, options...)
+	options := []hkp.HandlerOption{
+		hkp.StatsFunc(s.stats),
+		hkp.IndexTemplate(indexTemplate),
+		hkp.VIndexTemplate(vindexTemplate),
+		hkp.StatsTemplate(statsTemplate),
+	}
+	h, err := hkp.NewHandler(s.cacheStorage(s.searchStorage(s.ingestStorage(SourceHKP))), options...)
 	if err != nil {
 		return nil, errgo.Mask(err)
 	}
 	h.Register(s.r)
 
+	if s.verifier != nil {
+		// Lookups are served by a handler of their own, from the view of
+		// storage without unverified user IDs. Submissions and hash queries
+		// continue to see keys whole.
+		vh, err := hkp.NewHandler(s.cacheStorage(s.verifier.Storage(s.searchStorage(s.ingestStorage(SourceHKP)))), options...)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		lookups := httprouter.New()
+		vh.Register(lookups)
+		s.lookups = lookups
+	}
+
+	newVKS(settings, s.ingestStorage(SourceVKS), s.lookupStorage(), s.verifier).Register(s.r)
+	s.r.GET("/pks/search", (&search{lookups: s.lookupStorage(), verified: s.verifier != nil}).serve)
+
+	if settings.WKD != nil {
+		s.wkd = newWKD(settings, s.st, s.lookupStorage(), s.backend)
+		s.r.GET("/.well-known/openpgpkey/*path", s.wkd.serve)
+	}
+
 	if settings.Webroot != "" {
 		err := s.registerWebroot(settings.Webroot)
 		if err != nil {
 			return nil, errgo.Mask(err)
 		}
+	} else {
+		s.registerFiles(assets.FileSystem(assetsWebroot), assets.entries(assetsWebroot))
 	}
 
+	created = true
 	return s, nil
 }
 
+// verifiedLookups is middleware which routes HKP lookups to the handler
+// serving only verified user IDs.
+func (s *Server) verifiedLookups(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/pks/lookup" {
+			s.lookups.ServeHTTP(w, req)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// lookupStorage returns the view of storage from which keys are served
+// other than over HKP, without unverified user IDs if email verification is
+// enabled.
+func (s *Server) lookupStorage() storage.Storage {
+	st := s.searchStorage(s.st)
+	if s.verifier != nil {
+		return s.verifier.Storage(st)
+	}
+	return st
+}
+
+// cacheStorage returns st reporting the keys fetched by lookups to the
+// lookup cache, if one is configured.
+func (s *Server) cacheStorage(st storage.Storage) storage.Storage {
+	if s.cache != nil {
+		return s.cache.Storage(st)
+	}
+	return st
+}
+
+// searchStorage returns st with keyword searches answered from the search
+// index, if one is configured.
+func (s *Server) searchStorage(st storage.Storage) storage.Storage {
+	if s.sindex != nil {
+		return s.sindex.Storage(st)
+	}
+	return st
+}
+
+// ingestStorage returns the view of storage through which keys from source
+// are ingested. Keys are checked before their source is marked, as checks
+// may change their digests.
+func (s *Server) ingestStorage(source string) storage.Storage {
+	return s.ingestion.Storage(s.sources.Storage(s.st, source), source)
+}
+
 func DialStorage(settings *Settings) (storage.Storage, error) {
 	switch settings.OpenPGP.DB.Driver {
 	case "mongo":
@@ -126,6 +319,9 @@ type stats struct {
 	Software  string      `json:"software"`
 	Peers     []statsPeer `json:"peers"`
 
+	Policy []PolicyRuleStat `json:"policy,omitempty"`
+	Cache  *CacheStats      `json:"cache,omitempty"`
+
 	Total  int
 	Hourly []loadStat
 	Daily  []loadStat
@@ -144,8 +340,10 @@ func (s loadStats) Less(i, j int) bool { return s[i].Time.Before(s[j].Time) }
 
 type statsPeer struct {
 	Name      string
-	HTTPAddr  string `json:"httpAddr"`
-	ReconAddr string `json:"reconAddr"`
+	HTTPAddr  string         `json:"httpAddr"`
+	ReconAddr string         `json:"reconAddr"`
+	Paused    bool           `json:"paused,omitempty"`
+	Status    *partnerStatus `json:"status"`
 }
 
 type statsPeers []statsPeer
@@ -189,19 +387,26 @@ func (s *Server) stats() (interface{}, error) {
 		result.Daily = append(result.Daily, loadStat{LoadStat: v, Time: k})
 	}
 	sort.Sort(loadStats(result.Daily))
-	for k, v := range s.settings.Conflux.Recon.Settings.Partners {
+	for _, partner := range s.sksPeer.List() {
 		result.Peers = append(result.Peers, statsPeer{
-			Name:      k,
-			HTTPAddr:  v.HTTPAddr,
-			ReconAddr: v.ReconAddr,
+			Name:      partner.Name,
+			HTTPAddr:  partner.HTTPAddr,
+			ReconAddr: partner.ReconAddr,
+			Paused:    partner.Paused,
+			Status:    s.partners.Status(partner.Name),
 		})
 	}
 	sort.Sort(statsPeers(result.Peers))
+	if s.ingestion.Policy != nil {
+		result.Policy = s.ingestion.Policy.Stats()
+	}
+	if s.cache != nil {
+		result.Cache = s.cache.Stats()
+	}
 	return result, nil
 }
 
 func (s *Server) registerWebroot(webroot string) error {
-	fileServer := http.FileServer(http.Dir(webroot))
 	d, err := os.Open(webroot)
 	if os.IsNotExist(err) {
 		log.Errorf("webroot %q not found", webroot)
@@ -215,7 +420,14 @@ func (s *Server) registerWebroot(webroot string) error {
 	if err != nil {
 		return errgo.Mask(err)
 	}
+	s.registerFiles(http.Dir(webroot), files)
+	return nil
+}
 
+// registerFiles serves the files of fs from the root path, given its
+// top-level entries.
+func (s *Server) registerFiles(fs http.FileSystem, files []os.FileInfo) {
+	fileServer := http.FileServer(fs)
 	s.r.GET("/", func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
 		fileServer.ServeHTTP(w, req)
 	})
@@ -236,7 +448,6 @@ func (s *Server) registerWebroot(webroot string) error {
 			})
 		}
 	}
-	return nil
 }
 
 func (s *Server) Start() error {
@@ -246,9 +457,37 @@ func (s *Server) Start() error {
 	if s.settings.HKPS != nil {
 		s.t.Go(s.listenAndServeHKPS)
 	}
+	if s.settings.Admin != nil {
+		SetProfileRates(s.settings.Admin.BlockProfileRate, s.settings.Admin.MutexProfileFraction)
+		if s.settings.Admin.Bind != "" {
+			s.t.Go(s.listenAndServeAdmin)
+		}
+		if s.settings.Admin.Socket != "" {
+			s.t.Go(s.listenAndServeAdminSocket)
+		}
+	}
 
 	if s.sksPeer != nil {
-		s.sksPeer.Start()
+		s.partners.Start()
+		err := s.sksPeer.Start()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if s.pks != nil {
+		s.pks.Start()
+	}
+	if s.verifier != nil {
+		s.verifier.Start()
+	}
+	if s.wkd != nil {
+		s.wkd.Start()
+	}
+	if s.sindex != nil {
+		s.sindex.Start()
+	}
+	if s.webhooks != nil {
+		s.webhooks.Start()
 	}
 
 	return nil
@@ -260,11 +499,24 @@ type nopCloser struct {
 
 func (nopCloser) Close() error { return nil }
 
+// setLogLevel overrides the configured log level.
+func (s *Server) setLogLevel(level string) {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	s.logLevel = level
+}
+
 func (s *Server) openLog() {
+	s.logMu.Lock()
+	logLevel := s.logLevel
+	s.logMu.Unlock()
+	if logLevel == "" {
+		logLevel = s.settings.LogLevel
+	}
 	defer func() {
-		level, err := log.ParseLevel(strings.ToLower(s.settings.LogLevel))
+		level, err := log.ParseLevel(strings.ToLower(logLevel))
 		if err != nil {
-			log.Warningf("invalid LogLevel=%q: %v", s.settings.LogLevel, err)
+			log.Warningf("invalid LogLevel=%q: %v", logLevel, err)
 			return
 		}
 		log.SetLevel(level)
@@ -300,13 +552,80 @@ func (s *Server) Wait() error {
 func (s *Server) Stop() {
 	defer s.closeLog()
 
+	// A running stripping job is stopped first, as the recon peer must
+	// see its changes to storage.
+	if s.stripper != nil {
+		s.stripper.Close()
+	}
 	if s.sksPeer != nil {
-		s.sksPeer.Stop()
+		err := s.sksPeer.Stop()
+		if err != nil {
+			log.Errorf("recon peer: %v", err)
+		}
+		err = s.partners.Stop()
+		if err != nil {
+			log.Errorf("recon partner checks: %v", err)
+		}
 	}
+	if s.pks != nil {
+		err := s.pks.Stop()
+		if err != nil {
+			log.Errorf("PKS sync: %v", err)
+		}
+	}
+	if s.webhooks != nil {
+		err := s.webhooks.Stop()
+		if err != nil {
+			log.Errorf("webhooks: %v", err)
+		}
+	}
+	if s.verifier != nil {
+		err := s.verifier.Stop()
+		if err != nil {
+			log.Errorf("verifier: %v", err)
+		}
+	}
+	if s.wkd != nil {
+		err := s.wkd.Stop()
+		if err != nil {
+			log.Errorf("WKD: %v", err)
+		}
+	}
+	if s.sindex != nil {
+		err := s.sindex.Stop()
+		if err != nil {
+			log.Errorf("search index: %v", err)
+		}
+	}
+	s.closeResources()
 	s.t.Kill(nil)
 	s.t.Wait()
 }
 
+// closeResources closes the journal and the storage backend, and removes
+// the extracted templates. It is called when the server stops, or if it
+// cannot be created.
+func (s *Server) closeResources() {
+	if s.journal != nil {
+		err := s.journal.Close()
+		if err != nil {
+			log.Errorf("journal: %v", err)
+		}
+	}
+	if s.backend != nil {
+		err := s.backend.Close()
+		if err != nil {
+			log.Errorf("storage backend: %v", err)
+		}
+	}
+	if s.templatesDir != "" {
+		err := os.RemoveAll(s.templatesDir)
+		if err != nil {
+			log.Errorf("cannot remove embedded templates: %v", err)
+		}
+	}
+}
+
 // tcpKeepAliveListener sets TCP keep-alive timeouts on accepted
 // connections. It's used by listenAndServe and listenAndServeTLS so
 // dead TCP connections (e.g. closing laptop mid-download) eventually
@@ -347,12 +666,27 @@ func (s *Server) listenAndServeHKP() error {
 		return err
 	}
 	s.hkpAddr = ln.Addr().String()
-	return http.Serve(ln, s.middle)
+	return http.Serve(ln, plainHandler(&s.settings.HKP, s.middle))
+}
+
+// plainHandler returns the handler for the plain HKP listener, which also
+// serves HTTP/2 without TLS when h2c is configured. net/http only negotiates
+// HTTP/2 over TLS by itself.
+func plainHandler(settings *HKPConfig, h http.Handler) http.Handler {
+	if !settings.H2C {
+		return h
+	}
+	return h2c.NewHandler(h, &http2.Server{})
 }
 
 func (s *Server) listenAndServeHKPS() error {
+	// HTTP/2 is negotiated by ALPN, and served by net/http when the
+	// connection has negotiated it.
 	config := &tls.Config{
-		NextProtos: []string{"http/1.1"},
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if s.settings.HKPS.DisableHTTP2 {
+		config.NextProtos = []string{"http/1.1"}
 	}
 	var err error
 	config.Certificates = make([]tls.Certificate, 1)
@@ -361,7 +695,7 @@ func (s *Server) listenAndServeHKPS() error {
 		return errgo.Notef(err, "failed to load HKPS certificate=%q key=%q", s.settings.HKPS.Cert, s.settings.HKPS.Key)
 	}
 
-	ln, err := newListener(s, s.settings.HKP.Bind)
+	ln, err := newListener(s, s.settings.HKPS.Bind)
 	if err != nil {
 		return errgo.Mask(err)
 	}
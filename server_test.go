@@ -0,0 +1,61 @@
+package server
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+func TestPlainHandlerH2C(t *testing.T) {
+	proto := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		io.WriteString(w, req.Proto)
+	})
+	// An HTTP/2 client with prior knowledge, dialing without TLS.
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, config *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}}
+
+	for _, test := range []struct {
+		h2c  bool
+		want string
+	}{
+		{true, "HTTP/2.0"},
+		{false, ""},
+	} {
+		srv := httptest.NewServer(plainHandler(&HKPConfig{H2C: test.h2c}, proto))
+		resp, err := client.Get(srv.URL)
+		var got string
+		if err == nil {
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			got = string(body)
+		}
+		srv.Close()
+		if got != test.want {
+			t.Errorf("h2c %v: got %q, error %v; want %q", test.h2c, got, err, test.want)
+		}
+		if test.h2c {
+			// HTTP/1.1 clients are still served.
+			srv = httptest.NewServer(plainHandler(&HKPConfig{H2C: true}, proto))
+			resp, err = http.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			srv.Close()
+			if string(body) != "HTTP/1.1" {
+				t.Errorf("got %q, want HTTP/1.1", body)
+			}
+		}
+	}
+}
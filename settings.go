@@ -18,10 +18,22 @@
 package server
 
 import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
 	"github.com/BurntSushi/toml"
 	"gopkg.in/errgo.v1"
 
 	"gopkg.in/hockeypuck/conflux.v2/recon"
+	log "gopkg.in/hockeypuck/logrus.v0"
 )
 
 type confluxConfig struct {
@@ -35,6 +47,10 @@ type levelDB struct {
 type reconConfig struct {
 	recon.Settings
 	LevelDB levelDB `toml:"leveldb"`
+
+	// PartnerStateFile persists changes made to the partners through the
+	// admin API.
+	PartnerStateFile string `toml:"partnerStateFile"`
 }
 
 const (
@@ -43,29 +59,162 @@ const (
 
 type HKPConfig struct {
 	Bind string `toml:"bind"`
+
+	// H2C, if set, serves HTTP/2 without TLS on the plain HKP listener, to
+	// clients connecting with prior knowledge or upgrading from HTTP/1.1.
+	// It is meant for servers behind a proxy which speaks HTTP/2 to them.
+	H2C bool `toml:"h2c"`
 }
 
 type HKPSConfig struct {
 	HKPConfig
 	Cert string `toml:"cert"`
 	Key  string `toml:"key"`
+
+	// DisableHTTP2, if set, only offers HTTP/1.1 to TLS clients.
+	DisableHTTP2 bool `toml:"disableHTTP2"`
+}
+
+// CompressionConfig enables brotli and gzip compression of textual responses
+// for clients accepting them. Level is the gzip compression level, from 1
+// (fastest) to 9 (smallest), and BrotliLevel the brotli one, from 1 to 11.
+type CompressionConfig struct {
+	Level       int `toml:"level"`
+	BrotliLevel int `toml:"brotliLevel"`
+}
+
+const (
+	DefaultCompressionLevel = 6
+	DefaultBrotliLevel      = 5
+)
+
+func (c *CompressionConfig) setDefaults() {
+	if c.Level == 0 {
+		c.Level = DefaultCompressionLevel
+	}
+	if c.BrotliLevel == 0 {
+		c.BrotliLevel = DefaultBrotliLevel
+	}
 }
 
 type PKSConfig struct {
 	From string     `toml:"from"`
 	To   []string   `toml:"to"`
 	SMTP SMTPConfig `toml:"smtp"`
+
+	// QueueDir holds outbound sync mails until they have been sent.
+	QueueDir    string `toml:"queueDir"`
+	MaxAttempts int    `toml:"maxAttempts"`
+
+	// Maildir and Bind configure how inbound PKS mail is received: from a
+	// maildir delivered to by the local MTA, or by listening for SMTP.
+	Maildir string `toml:"maildir"`
+	Bind    string `toml:"bind"`
+
+	// MaxMessageBytes is the largest mail accepted over SMTP.
+	MaxMessageBytes int `toml:"maxMessageBytes"`
+}
+
+const (
+	DefaultSMTPHost           = "localhost:25"
+	DefaultPKSQueueDir        = "pks-queue"
+	DefaultPKSMaxAttempts     = 20
+	DefaultPKSMaxMessageBytes = 8 << 20
+)
+
+func (c *PKSConfig) setDefaults() {
+	if c.SMTP.Host == "" {
+		c.SMTP.Host = DefaultSMTPHost
+	}
+	if c.QueueDir == "" {
+		c.QueueDir = DefaultPKSQueueDir
+	}
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = DefaultPKSMaxAttempts
+	}
+	if c.MaxMessageBytes == 0 {
+		c.MaxMessageBytes = DefaultPKSMaxMessageBytes
+	}
+}
+
+// VerificationConfig enables publication of user IDs only once the owner of
+// each email address has confirmed it, by following a link mailed to them
+// through the SMTP server. BaseURL is the public URL of this keyserver, from
+// which the links are made. Links expire after TokenTTLHours.
+type VerificationConfig struct {
+	From          string     `toml:"from"`
+	SMTP          SMTPConfig `toml:"smtp"`
+	BaseURL       string     `toml:"baseURL"`
+	TokenTTLHours int        `toml:"tokenTTLHours"`
+}
+
+const DefaultVerificationTokenTTLHours = 48
+
+func (c *VerificationConfig) setDefaults() {
+	if c.SMTP.Host == "" {
+		c.SMTP.Host = DefaultSMTPHost
+	}
+	if c.TokenTTLHours == 0 {
+		c.TokenTTLHours = DefaultVerificationTokenTTLHours
+	}
+}
+
+// WKDConfig enables serving keys by the Web Key Directory protocol for the
+// user IDs in Domains. Policy is the content of the WKD policy file.
+type WKDConfig struct {
+	Domains []string `toml:"domains"`
+	Policy  string   `toml:"policy"`
+}
+
+// SearchConfig enables answering keyword searches from an index of user IDs
+// supporting prefix, fuzzy and field-scoped queries, saved to Path, in place
+// of the storage driver.
+type SearchConfig struct {
+	Path string `toml:"path"`
+}
+
+const DefaultSearchIndexPath = "search.idx"
+
+func (c *SearchConfig) setDefaults() {
+	if c.Path == "" {
+		c.Path = DefaultSearchIndexPath
+	}
+}
+
+// CacheConfig enables caching the responses to HKP get lookups by key ID or
+// fingerprint in memory. Responses are cached for up to MaxAgeSeconds, which
+// is also advertised to clients in Cache-Control headers, and the least
+// recently used are evicted beyond MaxEntries responses or MaxBytes in total.
+type CacheConfig struct {
+	MaxEntries    int `toml:"maxEntries"`
+	MaxBytes      int `toml:"maxBytes"`
+	MaxAgeSeconds int `toml:"maxAgeSeconds"`
 }
 
 const (
-	DefaultSMTPHost = "localhost:25"
+	DefaultCacheMaxEntries    = 1000
+	DefaultCacheMaxBytes      = 64 << 20
+	DefaultCacheMaxAgeSeconds = 300
 )
 
+func (c *CacheConfig) setDefaults() {
+	if c.MaxEntries == 0 {
+		c.MaxEntries = DefaultCacheMaxEntries
+	}
+	if c.MaxBytes == 0 {
+		c.MaxBytes = DefaultCacheMaxBytes
+	}
+	if c.MaxAgeSeconds == 0 {
+		c.MaxAgeSeconds = DefaultCacheMaxAgeSeconds
+	}
+}
+
 type SMTPConfig struct {
-	Host     string `toml:"host"`
-	ID       string `toml:"id"`
-	User     string `toml:"user"`
-	Password string `toml:"pass"`
+	Host         string `toml:"host"`
+	ID           string `toml:"id"`
+	User         string `toml:"user"`
+	Password     string `toml:"pass"`
+	PasswordFile string `toml:"passFile"`
 }
 
 const (
@@ -74,9 +223,10 @@ const (
 )
 
 type DBConfig struct {
-	Driver string       `toml:"driver"`
-	DSN    string       `toml:"dsn"`
-	Mongo  *mongoConfig `toml:"mongo"`
+	Driver  string       `toml:"driver"`
+	DSN     string       `toml:"dsn"`
+	DSNFile string       `toml:"dsnFile"`
+	Mongo   *mongoConfig `toml:"mongo"`
 }
 
 type mongoConfig struct {
@@ -84,17 +234,149 @@ type mongoConfig struct {
 	Collection string `toml:"collection"`
 }
 
+// WebhooksConfig configures notifications of key changes to HTTP endpoints.
+type WebhooksConfig struct {
+	// QueueDir holds pending deliveries until they have succeeded.
+	QueueDir string                   `toml:"queueDir"`
+	Hooks    map[string]WebhookConfig `toml:"hook"`
+}
+
+// WebhookConfig configures a single webhook. If any of Fingerprints, KeyIDs
+// or Domains are set, only changes to keys matching at least one of them are
+// delivered. Deliveries are signed with Secret, or the contents of
+// SecretFile, one of which is required.
+type WebhookConfig struct {
+	URL          string   `toml:"url"`
+	Secret       string   `toml:"secret"`
+	SecretFile   string   `toml:"secretFile"`
+	Fingerprints []string `toml:"fingerprints"`
+	KeyIDs       []string `toml:"keyIDs"`
+	Domains      []string `toml:"domains"`
+	MaxAttempts  int      `toml:"maxAttempts"`
+}
+
+const (
+	DefaultWebhookQueueDir    = "webhook-queue"
+	DefaultWebhookMaxAttempts = 20
+)
+
+// AdminConfig configures the admin HTTP listener, which serves operational
+// endpoints on a TCP address, a unix socket, or both.
+//
+// Clients connecting to Bind authenticate by presenting Token as a bearer
+// token, or, if ClientCA is set, a TLS client certificate signed by it. Cert
+// and Key enable TLS on Bind, which is required to use a token unless Bind is
+// a loopback address. Clients connecting to Socket are authenticated
+// by the socket's file permissions, which only allow its owner.
+type AdminConfig struct {
+	Bind      string `toml:"bind"`
+	Socket    string `toml:"socket"`
+	Token     string `toml:"token"`
+	TokenFile string `toml:"tokenFile"`
+	Cert      string `toml:"cert"`
+	Key       string `toml:"key"`
+	ClientCA  string `toml:"clientCA"`
+
+	// ProfileDir is where profiles requested through the admin API are
+	// written.
+	ProfileDir string `toml:"profileDir"`
+
+	// BlockProfileRate and MutexProfileFraction enable the block and mutex
+	// profiles served under /debug/pprof/, as set by
+	// runtime.SetBlockProfileRate and runtime.SetMutexProfileFraction.
+	BlockProfileRate     int `toml:"blockProfileRate"`
+	MutexProfileFraction int `toml:"mutexProfileFraction"`
+}
+
+// JournalConfig configures the on-disk journal of key changes, from which
+// the change stream is served.
+type JournalConfig struct {
+	Dir string `toml:"dir"`
+
+	// MaxEntries is the approximate number of changes retained; older
+	// changes are pruned as new ones are recorded.
+	MaxEntries int `toml:"maxEntries"`
+
+	// MaxAgeDays, if set, also prunes changes older than this many days.
+	MaxAgeDays int `toml:"maxAgeDays"`
+
+	// Public, if set, serves the change stream and journal queries on the
+	// HKP listener as well as the admin listener. They disclose the source
+	// of each change, such as which submission path it came from.
+	Public bool `toml:"public"`
+}
+
+const (
+	DefaultJournalDir        = "journal"
+	DefaultJournalMaxEntries = 1000000
+)
+
+func (c *JournalConfig) setDefaults() {
+	if c.Dir == "" {
+		c.Dir = DefaultJournalDir
+	}
+	if c.MaxEntries == 0 {
+		c.MaxEntries = DefaultJournalMaxEntries
+	}
+}
+
 const (
 	DefaultStatsRefreshHours = 4
 	DefaultNWorkers          = 8
 )
 
 type OpenPGPConfig struct {
-	PKS      *PKSConfig `toml:"pks"`
-	NWorkers int        `toml:"nworkers"`
-	DB       DBConfig   `toml:"db"`
+	PKS        *PKSConfig       `toml:"pks"`
+	NWorkers   int              `toml:"nworkers"`
+	DB         DBConfig         `toml:"db"`
+	Tombstones TombstonesConfig `toml:"tombstones"`
+
+	// PolicyFile, if set, names a file of rules rejecting keys by
+	// fingerprint, key ID, user ID or email domain. It is reloaded when it
+	// changes.
+	PolicyFile string `toml:"policyFile"`
+
+	Limits *LimitsConfig `toml:"limits"`
+
+	// StripCertifications, if set, removes third-party certifications from
+	// keys, leaving only self-signatures. It is one of "ingest", which
+	// strips keys as they are written to storage and as they are served, or
+	// "output", which stores keys whole and strips them only as they are
+	// served.
+	StripCertifications string `toml:"stripCertifications"`
+
+	// Verification, if set, hides user IDs from lookups until their email
+	// addresses have been verified.
+	Verification *VerificationConfig `toml:"verification"`
+}
+
+// LimitsConfig bounds the size of keys accepted, to defend against
+// certificate flooding. A limit of zero is not enforced. Mode is one of
+// "reject", "truncate" or "strip", and determines how keys exceeding the
+// limits are handled.
+type LimitsConfig struct {
+	MaxKeyBytes           int    `toml:"maxKeyBytes"`
+	MaxUserIDs            int    `toml:"maxUserIDs"`
+	MaxUIDSignatures      int    `toml:"maxUIDSignatures"`
+	MaxUserAttributeBytes int    `toml:"maxUserAttributeBytes"`
+	Mode                  string `toml:"mode"`
+}
+
+const DefaultLimitsMode = LimitModeReject
+
+// TombstonesConfig configures the record of deleted keys. File holds a
+// tombstone for each deleted key, which prevents it from being accepted
+// again; AuditLog records each deletion and restoration, and who made it.
+type TombstonesConfig struct {
+	File     string `toml:"file"`
+	AuditLog string `toml:"auditLog"`
 }
 
+const (
+	DefaultTombstonesFile     = "tombstones.json"
+	DefaultTombstonesAuditLog = "deletions.log"
+)
+
 func DefaultOpenPGP() OpenPGPConfig {
 	return OpenPGPConfig{
 		NWorkers: DefaultNWorkers,
@@ -102,24 +384,49 @@ func DefaultOpenPGP() OpenPGPConfig {
 			Driver: DefaultDBDriver,
 			DSN:    DefaultDBDSN,
 		},
+		Tombstones: TombstonesConfig{
+			File:     DefaultTombstonesFile,
+			AuditLog: DefaultTombstonesAuditLog,
+		},
 	}
 }
 
 type Settings struct {
+	// ConfD is a directory of TOML configuration fragments, which are
+	// applied in lexical order after the main configuration.
+	ConfD string `toml:"confd"`
+
 	Conflux confluxConfig `toml:"conflux"`
 
+	// IndexTemplate, VIndexTemplate and StatsTemplate, if set, name the
+	// templates of the index, vindex and stats pages, in place of those
+	// embedded in the server.
 	IndexTemplate  string `toml:"indexTemplate"`
 	VIndexTemplate string `toml:"vindexTemplate"`
 	StatsTemplate  string `toml:"statsTemplate"`
 
+	// AssetsDir, if set, holds files overriding the embedded web UI under
+	// www and templates under templates, as written by the assets command.
+	AssetsDir string `toml:"assetsDir"`
+
 	HKP  HKPConfig   `toml:"hkp"`
 	HKPS *HKPSConfig `toml:"hkps"`
 
+	Compression *CompressionConfig `toml:"compression"`
+
 	OpenPGP OpenPGPConfig `toml:"openpgp"`
 
+	Webhooks *WebhooksConfig `toml:"webhooks"`
+	Journal  *JournalConfig  `toml:"journal"`
+	Admin    *AdminConfig    `toml:"admin"`
+	WKD      *WKDConfig      `toml:"wkd"`
+	Search   *SearchConfig   `toml:"search"`
+	Cache    *CacheConfig    `toml:"cache"`
+
 	LogFile  string `toml:"logfile"`
 	LogLevel string `toml:"loglevel"`
 
+	// Webroot, if set, is served in place of the embedded web UI.
 	Webroot string `toml:"webroot"`
 
 	Contact  string `toml:"contact"`
@@ -131,6 +438,8 @@ type Settings struct {
 const (
 	DefaultLogLevel    = "INFO"
 	DefaultLevelDBPath = "recon.db"
+
+	DefaultPartnerStateFile = "partners.json"
 )
 
 func DefaultSettings() Settings {
@@ -142,6 +451,7 @@ func DefaultSettings() Settings {
 				LevelDB: levelDB{
 					Path: DefaultLevelDBPath,
 				},
+				PartnerStateFile: DefaultPartnerStateFile,
 			},
 		},
 		HKP: HKPConfig{
@@ -154,16 +464,93 @@ func DefaultSettings() Settings {
 	}
 }
 
+// ParseSettings parses the TOML configuration in data. Keys that do not
+// correspond to any setting are logged and otherwise ignored.
 func ParseSettings(data string) (*Settings, error) {
+	return parseSettings(data, false)
+}
+
+// ParseSettingsStrict parses the TOML configuration in data like
+// ParseSettings, but returns an *UnknownKeysError if any keys do not
+// correspond to a setting.
+func ParseSettingsStrict(data string) (*Settings, error) {
+	return parseSettings(data, true)
+}
+
+func parseSettings(data string, strict bool) (*Settings, error) {
 	var doc struct {
 		Hockeypuck Settings `toml:"hockeypuck"`
 	}
 	doc.Hockeypuck = DefaultSettings()
-	_, err := toml.Decode(data, &doc)
+	unknownErr := &UnknownKeysError{}
+	err := decodeSettings("", data, &doc, unknownErr)
 	if err != nil {
 		return nil, errgo.Mask(err)
 	}
 
+	confd := os.Getenv(envPrefix + "_CONFD")
+	if confd == "" {
+		confd = doc.Hockeypuck.ConfD
+	}
+	if confd != "" {
+		files, err := confFragments(confd)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		for _, file := range files {
+			fragment, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, errgo.Mask(err)
+			}
+			err = decodeSettings(file, string(fragment), &doc, unknownErr)
+			if err != nil {
+				return nil, errgo.Notef(err, "invalid config fragment %q", file)
+			}
+		}
+	}
+
+	if len(unknownErr.Keys) > 0 {
+		if strict {
+			return nil, unknownErr
+		}
+		for _, key := range unknownErr.Keys {
+			log.Warningf("ignoring unknown config key %v", key)
+		}
+	}
+
+	err = doc.Hockeypuck.readSecretFiles()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	err = applyEnv(&doc.Hockeypuck, os.Environ())
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	if doc.Hockeypuck.OpenPGP.PKS != nil {
+		doc.Hockeypuck.OpenPGP.PKS.setDefaults()
+	}
+	if doc.Hockeypuck.OpenPGP.Verification != nil {
+		doc.Hockeypuck.OpenPGP.Verification.setDefaults()
+	}
+	if doc.Hockeypuck.Webhooks != nil && doc.Hockeypuck.Webhooks.QueueDir == "" {
+		doc.Hockeypuck.Webhooks.QueueDir = DefaultWebhookQueueDir
+	}
+	if doc.Hockeypuck.Journal != nil {
+		doc.Hockeypuck.Journal.setDefaults()
+	}
+	if doc.Hockeypuck.Search != nil {
+		doc.Hockeypuck.Search.setDefaults()
+	}
+	if doc.Hockeypuck.Cache != nil {
+		doc.Hockeypuck.Cache.setDefaults()
+	}
+	if doc.Hockeypuck.Compression != nil {
+		doc.Hockeypuck.Compression.setDefaults()
+	}
+	if doc.Hockeypuck.OpenPGP.Limits != nil && doc.Hockeypuck.OpenPGP.Limits.Mode == "" {
+		doc.Hockeypuck.OpenPGP.Limits.Mode = DefaultLimitsMode
+	}
+
 	err = doc.Hockeypuck.Conflux.Recon.Settings.Resolve()
 	if err != nil {
 		return nil, errgo.Mask(err)
@@ -171,3 +558,437 @@ func ParseSettings(data string) (*Settings, error) {
 
 	return &doc.Hockeypuck, nil
 }
+
+// decodeSettings decodes the TOML document data, read from file, into doc.
+// Any keys that were not decoded are added to unknownErr.
+func decodeSettings(file, data string, doc interface{}, unknownErr *UnknownKeysError) error {
+	md, err := toml.Decode(data, doc)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		lines := keyLines(data)
+		for _, key := range undecoded {
+			name := key.String()
+			unknownErr.Keys = append(unknownErr.Keys, UnknownKey{Key: name, File: file, Line: lines[name]})
+		}
+	}
+	return nil
+}
+
+// confFragments returns the TOML configuration fragments in dir, in the order
+// in which they are applied.
+func confFragments(dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var files []string
+	for _, fi := range fis {
+		ext := filepath.Ext(fi.Name())
+		if !fi.IsDir() && (ext == ".conf" || ext == ".toml") {
+			files = append(files, filepath.Join(dir, fi.Name()))
+		}
+	}
+	return files, nil
+}
+
+// readSecretFiles replaces secrets with the contents of the files configured
+// to hold them, if any.
+func (s *Settings) readSecretFiles() error {
+	var err error
+	if s.OpenPGP.DB.DSNFile != "" {
+		s.OpenPGP.DB.DSN, err = readSecretFile(s.OpenPGP.DB.DSNFile)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if s.OpenPGP.PKS != nil && s.OpenPGP.PKS.SMTP.PasswordFile != "" {
+		s.OpenPGP.PKS.SMTP.Password, err = readSecretFile(s.OpenPGP.PKS.SMTP.PasswordFile)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if s.OpenPGP.Verification != nil && s.OpenPGP.Verification.SMTP.PasswordFile != "" {
+		s.OpenPGP.Verification.SMTP.Password, err = readSecretFile(s.OpenPGP.Verification.SMTP.PasswordFile)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if s.Admin != nil && s.Admin.TokenFile != "" {
+		s.Admin.Token, err = readSecretFile(s.Admin.TokenFile)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if s.Webhooks != nil {
+		for name, hook := range s.Webhooks.Hooks {
+			if hook.SecretFile == "" {
+				continue
+			}
+			hook.Secret, err = readSecretFile(hook.SecretFile)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			s.Webhooks.Hooks[name] = hook
+		}
+	}
+	return nil
+}
+
+func readSecretFile(path string) (string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errgo.Notef(err, "cannot read secret file")
+	}
+	return strings.TrimRight(string(buf), "\r\n"), nil
+}
+
+// UnknownKey is a configuration key that does not correspond to any setting.
+type UnknownKey struct {
+	Key  string
+	File string
+	Line int
+}
+
+func (k UnknownKey) String() string {
+	switch {
+	case k.File != "" && k.Line > 0:
+		return fmt.Sprintf("%q (%s:%d)", k.Key, k.File, k.Line)
+	case k.File != "":
+		return fmt.Sprintf("%q (%s)", k.Key, k.File)
+	case k.Line > 0:
+		return fmt.Sprintf("%q (line %d)", k.Key, k.Line)
+	}
+	return fmt.Sprintf("%q", k.Key)
+}
+
+// UnknownKeysError is returned by ParseSettingsStrict when the configuration
+// contains unknown keys.
+type UnknownKeysError struct {
+	Keys []UnknownKey
+}
+
+func (e *UnknownKeysError) Error() string {
+	keys := make([]string, len(e.Keys))
+	for i := range e.Keys {
+		keys[i] = e.Keys[i].String()
+	}
+	return "unknown config keys: " + strings.Join(keys, ", ")
+}
+
+// keyLines returns the line number on which each key and table in the TOML
+// document data is defined. The toml package does not report positions of
+// keys, so this is a best-effort scan of the document.
+func keyLines(data string) map[string]int {
+	lines := make(map[string]int)
+	var table []string
+	var multiline string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if multiline != "" {
+			if strings.Count(line, multiline)%2 == 1 {
+				multiline = ""
+			}
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			name := strings.Trim(line, "[] \t")
+			if i := strings.Index(name, "#"); i >= 0 {
+				name = strings.TrimRight(strings.TrimSpace(name[:i]), "]")
+			}
+			table = splitKey(name)
+			if _, ok := lines[strings.Join(table, ".")]; !ok {
+				lines[strings.Join(table, ".")] = n
+			}
+			continue
+		}
+		i := strings.Index(line, "=")
+		if i < 0 {
+			continue
+		}
+		key := append(append([]string(nil), table...), splitKey(line[:i])...)
+		if _, ok := lines[strings.Join(key, ".")]; !ok {
+			lines[strings.Join(key, ".")] = n
+		}
+		for _, delim := range []string{`"""`, "'''"} {
+			if strings.Count(line[i:], delim)%2 == 1 {
+				multiline = delim
+			}
+		}
+	}
+	return lines
+}
+
+func splitKey(s string) []string {
+	var parts []string
+	for _, part := range strings.Split(s, ".") {
+		parts = append(parts, strings.Trim(strings.TrimSpace(part), `"'`))
+	}
+	return parts
+}
+
+// ValidationError describes all the problems found when validating
+// settings.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid settings: " + strings.Join(e.Problems, "; ")
+}
+
+// isLoopback returns whether addr is a host:port address on which only local
+// clients can connect.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Validate checks that the settings are usable: that addresses can be bound
+// or dialed, referenced files exist, and the storage driver is supported. If
+// not, a *ValidationError describing every problem found is returned.
+func (s *Settings) Validate() error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	checkAddr := func(name, addr string) {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addf("%s: invalid address %q: %v", name, addr, err)
+		}
+	}
+	checkFile := func(name, path string, dir bool) {
+		if path == "" {
+			return
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			addf("%s: %v", name, err)
+		} else if fi.IsDir() != dir {
+			if dir {
+				addf("%s: %q is not a directory", name, path)
+			} else {
+				addf("%s: %q is a directory", name, path)
+			}
+		}
+	}
+
+	checkAddr("hkp.bind", s.HKP.Bind)
+	if s.HKPS != nil {
+		checkAddr("hkps.bind", s.HKPS.Bind)
+		checkFile("hkps.cert", s.HKPS.Cert, false)
+		checkFile("hkps.key", s.HKPS.Key, false)
+		if s.HKPS.H2C {
+			addf("hkps.h2c: only supported on the plain hkp listener")
+		}
+		if s.HKPS.Cert == "" || s.HKPS.Key == "" {
+			addf("hkps: cert and key are required")
+		}
+	}
+
+	checkFile("assetsDir", s.AssetsDir, true)
+	checkFile("indexTemplate", s.IndexTemplate, false)
+	checkFile("vindexTemplate", s.VIndexTemplate, false)
+	checkFile("statsTemplate", s.StatsTemplate, false)
+	checkFile("webroot", s.Webroot, true)
+
+	if _, err := log.ParseLevel(strings.ToLower(s.LogLevel)); err != nil {
+		addf("loglevel: %v", err)
+	}
+
+	switch s.OpenPGP.DB.Driver {
+	case "mongo", "postgres-jsonb":
+	default:
+		addf("openpgp.db.driver: storage driver %q not supported", s.OpenPGP.DB.Driver)
+	}
+	if s.OpenPGP.DB.DSN == "" {
+		addf("openpgp.db.dsn: missing")
+	}
+	if s.OpenPGP.Tombstones.File == "" {
+		addf("openpgp.tombstones.file: missing")
+	}
+	if s.OpenPGP.Tombstones.AuditLog == "" {
+		addf("openpgp.tombstones.auditLog: missing")
+	}
+	if s.OpenPGP.PolicyFile != "" {
+		if _, err := loadPolicy(s.OpenPGP.PolicyFile); err != nil {
+			addf("openpgp.policyFile: %v", err)
+		}
+	}
+	if limits := s.OpenPGP.Limits; limits != nil {
+		switch limits.Mode {
+		case LimitModeReject, LimitModeTruncate, LimitModeStrip:
+		default:
+			addf("openpgp.limits.mode: %q is not one of reject, truncate or strip", limits.Mode)
+		}
+		if limits.MaxKeyBytes < 0 || limits.MaxUserIDs < 0 || limits.MaxUIDSignatures < 0 || limits.MaxUserAttributeBytes < 0 {
+			addf("openpgp.limits: limits must not be negative")
+		}
+	}
+	switch s.OpenPGP.StripCertifications {
+	case "", StripOnIngest, StripOnOutput:
+	default:
+		addf("openpgp.stripCertifications: %q is not one of ingest or output", s.OpenPGP.StripCertifications)
+	}
+	if v := s.OpenPGP.Verification; v != nil {
+		if v.From == "" {
+			addf("openpgp.verification.from: missing")
+		}
+		if _, err := mail.ParseAddress(v.From); v.From != "" && err != nil {
+			addf("openpgp.verification.from: %v", err)
+		}
+		checkAddr("openpgp.verification.smtp.host", v.SMTP.Host)
+		if u, err := url.Parse(v.BaseURL); err != nil {
+			addf("openpgp.verification.baseURL: %v", err)
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			addf("openpgp.verification.baseURL: %q is not an http(s) URL", v.BaseURL)
+		}
+		if v.TokenTTLHours < 0 {
+			addf("openpgp.verification.tokenTTLHours: must not be negative")
+		}
+	}
+	if s.WKD != nil {
+		if len(s.WKD.Domains) == 0 {
+			addf("wkd.domains: missing")
+		}
+		for _, domain := range s.WKD.Domains {
+			if domain == "" || strings.ContainsAny(domain, "/@: ") {
+				addf("wkd.domains: invalid domain %q", domain)
+			}
+		}
+	}
+	if s.Compression != nil && (s.Compression.Level < 1 || s.Compression.Level > 9) {
+		addf("compression.level: must be from 1 to 9")
+	}
+	if s.Compression != nil && (s.Compression.BrotliLevel < 1 || s.Compression.BrotliLevel > 11) {
+		addf("compression.brotliLevel: must be from 1 to 11")
+	}
+	if s.Cache != nil {
+		if s.Cache.MaxEntries < 0 {
+			addf("cache.maxEntries: must not be negative")
+		}
+		if s.Cache.MaxBytes < 0 {
+			addf("cache.maxBytes: must not be negative")
+		}
+		if s.Cache.MaxAgeSeconds < 0 {
+			addf("cache.maxAgeSeconds: must not be negative")
+		}
+	}
+	if s.Search != nil {
+		if fi, err := os.Stat(s.Search.Path); err == nil && fi.IsDir() {
+			addf("search.path: %q is a directory", s.Search.Path)
+		}
+	}
+	if pks := s.OpenPGP.PKS; pks != nil {
+		if pks.From == "" {
+			addf("openpgp.pks.from: missing")
+		}
+		if _, err := mail.ParseAddress(pks.From); pks.From != "" && err != nil {
+			addf("openpgp.pks.from: %v", err)
+		}
+		checkAddr("openpgp.pks.smtp.host", pks.SMTP.Host)
+		if pks.Bind != "" {
+			checkAddr("openpgp.pks.bind", pks.Bind)
+		}
+		if pks.MaxMessageBytes < 0 {
+			addf("openpgp.pks.maxMessageBytes: must not be negative")
+		}
+		checkFile("openpgp.pks.maildir", pks.Maildir, true)
+	}
+
+	if s.Webhooks != nil {
+		var names []string
+		for name := range s.Webhooks.Hooks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !validWebhookName(name) {
+				addf("webhooks.hook.%s: names may only contain letters, digits, '-', '_' and '.', and not start with '.'", name)
+			}
+			u, err := url.Parse(s.Webhooks.Hooks[name].URL)
+			if err != nil {
+				addf("webhooks.hook.%s.url: %v", name, err)
+			} else if u.Scheme != "http" && u.Scheme != "https" {
+				addf("webhooks.hook.%s.url: %q is not an http(s) URL", name, s.Webhooks.Hooks[name].URL)
+			}
+			if s.Webhooks.Hooks[name].Secret == "" && s.Webhooks.Hooks[name].SecretFile == "" {
+				addf("webhooks.hook.%s: secret or secretFile is required to sign deliveries", name)
+			}
+		}
+	}
+
+	if admin := s.Admin; admin != nil {
+		if admin.Bind == "" && admin.Socket == "" {
+			addf("admin: bind or socket is required")
+		}
+		if admin.Bind != "" {
+			checkAddr("admin.bind", admin.Bind)
+			if admin.Token == "" && admin.ClientCA == "" {
+				addf("admin: token, tokenFile or clientCA is required with bind")
+			}
+			if admin.Token != "" && admin.Cert == "" && !isLoopback(admin.Bind) {
+				addf("admin.bind: a token may only be sent without TLS to a loopback address; set cert and key")
+			}
+		}
+		if (admin.Cert == "") != (admin.Key == "") {
+			addf("admin: cert and key must be set together")
+		}
+		if admin.ClientCA != "" && admin.Cert == "" {
+			addf("admin.clientCA: requires cert and key")
+		}
+		checkFile("admin.cert", admin.Cert, false)
+		checkFile("admin.key", admin.Key, false)
+		checkFile("admin.clientCA", admin.ClientCA, false)
+		checkFile("admin.profileDir", admin.ProfileDir, true)
+		if admin.BlockProfileRate < 0 {
+			addf("admin.blockProfileRate: must not be negative")
+		}
+		if admin.MutexProfileFraction < 0 {
+			addf("admin.mutexProfileFraction: must not be negative")
+		}
+	}
+	if s.Journal != nil {
+		if s.Journal.MaxEntries < 0 {
+			addf("journal.maxEntries: must not be negative")
+		}
+		if s.Journal.MaxAgeDays < 0 {
+			addf("journal.maxAgeDays: must not be negative")
+		}
+	}
+
+	reconSettings := &s.Conflux.Recon.Settings
+	if reconSettings.HTTPAddr != "" {
+		checkAddr("conflux.recon.httpAddr", reconSettings.HTTPAddr)
+	}
+	if reconSettings.ReconAddr != "" {
+		checkAddr("conflux.recon.reconAddr", reconSettings.ReconAddr)
+	}
+	var names []string
+	for name := range reconSettings.Partners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		partner := reconSettings.Partners[name]
+		checkAddr("conflux.recon.partner."+name+".httpAddr", partner.HTTPAddr)
+		checkAddr("conflux.recon.partner."+name+".reconAddr", partner.ReconAddr)
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"sync"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// Sources of key changes, as recorded in the journal.
+const (
+	SourceHKP   = "hkp"
+	SourceRecon = "recon"
+	SourcePKS   = "pks"
+	SourceLoad  = "load"
+	SourceAdmin = "admin"
+	SourceVKS   = "vks"
+)
+
+// sourceTracker attributes storage key changes to the component that made
+// them. Each component is given its own view of storage, which marks the
+// digests of keys it writes for the duration of the write; subscribers
+// notified of the change during the write may then look up its source.
+type sourceTracker struct {
+	mu      sync.Mutex
+	pending map[string]string
+}
+
+func newSourceTracker() *sourceTracker {
+	return &sourceTracker{pending: make(map[string]string)}
+}
+
+// Storage returns a view of st which attributes writes to source.
+func (t *sourceTracker) Storage(st storage.Storage, source string) storage.Storage {
+	return &sourceStorage{Storage: st, tracker: t, source: source}
+}
+
+// Source returns the source of the change being made to the key with the
+// given digest, or "" if unknown.
+func (t *sourceTracker) Source(digest string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pending[digest]
+}
+
+func (t *sourceTracker) mark(source string, digests []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, digest := range digests {
+		t.pending[digest] = source
+	}
+}
+
+func (t *sourceTracker) unmark(digests []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, digest := range digests {
+		delete(t.pending, digest)
+	}
+}
+
+type sourceStorage struct {
+	storage.Storage
+	tracker *sourceTracker
+	source  string
+}
+
+func (st *sourceStorage) Insert(keys []*openpgp.PrimaryKey) (int, error) {
+	digests := make([]string, len(keys))
+	for i, key := range keys {
+		digests[i] = key.MD5
+	}
+	st.tracker.mark(st.source, digests)
+	defer st.tracker.unmark(digests)
+	return st.Storage.Insert(keys)
+}
+
+func (st *sourceStorage) Update(key *openpgp.PrimaryKey, priorMD5 string) error {
+	digests := []string{key.MD5}
+	st.tracker.mark(st.source, digests)
+	defer st.tracker.unmark(digests)
+	return st.Storage.Update(key, priorMD5)
+}
+
+func (st *sourceStorage) Notify(kc storage.KeyChange) error {
+	digests := append(kc.InsertDigests(), kc.RemoveDigests()...)
+	st.tracker.mark(st.source, digests)
+	defer st.tracker.unmark(digests)
+	return st.Storage.Notify(kc)
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/errgo.v1"
+)
+
+// spool is a persistent queue of pending work, stored as one JSON file per
+// entry in a directory so that it survives restarts. Entries are listed in
+// the order in which they were added.
+type spool struct {
+	dir string
+
+	mu   sync.Mutex
+	last int64
+}
+
+const (
+	spoolExt       = ".json"
+	spoolFailedDir = "failed"
+)
+
+func newSpool(dir string) (*spool, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return &spool{dir: dir}, nil
+}
+
+// nextName returns a unique, ordered name for a new entry.
+func (s *spool) nextName() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().UnixNano()
+	if now <= s.last {
+		now = s.last + 1
+	}
+	s.last = now
+	return fmt.Sprintf("%020d%s", now, spoolExt)
+}
+
+// Put adds a new entry to the spool.
+func (s *spool) Put(v interface{}) error {
+	return s.write(s.nextName(), v)
+}
+
+// Update replaces the contents of the named entry.
+func (s *spool) Update(name string, v interface{}) error {
+	return s.write(name, v)
+}
+
+func (s *spool) write(name string, v interface{}) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	path := filepath.Join(s.dir, name)
+	err = ioutil.WriteFile(path+".part", buf, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(os.Rename(path+".part", path))
+}
+
+// List returns the names of all entries in the spool, oldest first.
+func (s *spool) List() ([]string, error) {
+	fis, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var names []string
+	for _, fi := range fis {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), spoolExt) {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Get reads the named entry into v.
+func (s *spool) Get(name string, v interface{}) error {
+	buf, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return errgo.Mask(err, os.IsNotExist)
+	}
+	return errgo.Mask(json.Unmarshal(buf, v))
+}
+
+// Remove removes the named entry from the spool.
+func (s *spool) Remove(name string) error {
+	err := os.Remove(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return errgo.Mask(err)
+}
+
+// Fail moves the named entry out of the spool into its failed subdirectory,
+// where it is kept for inspection but no longer listed.
+func (s *spool) Fail(name string) error {
+	failedDir := filepath.Join(s.dir, spoolFailedDir)
+	err := os.MkdirAll(failedDir, 0755)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	return errgo.Mask(os.Rename(filepath.Join(s.dir, name), filepath.Join(failedDir, name)))
+}
+
+// retryBackoff returns how long to wait before making another attempt, after
+// the given number of failed attempts.
+func retryBackoff(attempts int, min, max time.Duration) time.Duration {
+	d := min
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
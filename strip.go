@@ -0,0 +1,290 @@
+package server
+
+import (
+	"bytes"
+	"crypto/md5"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// Modes of stripping third-party certifications.
+const (
+	// StripOnIngest strips keys as they are written to storage, and as
+	// they are served, in case storage holds keys written before stripping
+	// was enabled.
+	StripOnIngest = "ingest"
+
+	// StripOnOutput stores keys whole and strips them only as they are
+	// served.
+	StripOnOutput = "output"
+)
+
+// stripKey is a KeyCheck which removes third-party certifications from key,
+// giving it a new digest if any were removed. It never refuses a key.
+// Partners which do not strip keep offering the key whole; see
+// Ingestion.Storage.
+func stripKey(key *openpgp.PrimaryKey, source string) error {
+	if n := stripCertifications(key); n > 0 {
+		key.MD5 = openpgp.SksDigest(key, md5.New())
+		log.WithFields(log.Fields{
+			"fingerprint": key.Fingerprint(),
+			"source":      source,
+			"stripped":    n,
+		}).Debug("third-party certifications stripped")
+	}
+	return nil
+}
+
+// stripArmoredKeys removes third-party certifications from the keys in an
+// armored key block, returning the re-armored result.
+func stripArmoredKeys(armored []byte) ([]byte, error) {
+	results, err := openpgp.ReadArmorKeys(bytes.NewReader(armored))
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var keys []*openpgp.PrimaryKey
+	for result := range results {
+		if result.Error != nil {
+			return nil, errgo.Mask(result.Error)
+		}
+		stripCertifications(result.PrimaryKey)
+		keys = append(keys, result.PrimaryKey)
+	}
+	if len(keys) == 0 {
+		return nil, errgo.New("no keys found")
+	}
+	var buf bytes.Buffer
+	err = openpgp.WriteArmoredPackets(&buf, keys)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// bufferedResponse holds a response so that it may be rewritten before it is
+// sent.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) Write(p []byte) (int, error) {
+	return r.body.Write(p)
+}
+
+func (r *bufferedResponse) WriteHeader(status int) {
+	r.status = status
+}
+
+// stripLookups is middleware which removes third-party certifications from
+// the keys served by HKP get and hget lookups. Hash queries from recon
+// partners are left alone, so that they receive keys matching the digests
+// in the prefix tree.
+func stripLookups(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/pks/lookup" {
+			next.ServeHTTP(w, req)
+			return
+		}
+		if op := req.URL.Query().Get("op"); op != "get" && op != "hget" {
+			next.ServeHTTP(w, req)
+			return
+		}
+		resp := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(resp, req)
+		body := resp.body.Bytes()
+		if resp.status == http.StatusOK {
+			stripped, err := stripArmoredKeys(body)
+			if err != nil {
+				log.Warningf("cannot strip certifications from %q response: %v", req.URL.String(), err)
+			} else {
+				body = stripped
+			}
+		}
+		w.Header().Del("Content-Length")
+		w.WriteHeader(resp.status)
+		w.Write(body)
+	})
+}
+
+// ErrStripRunning is the cause of errors resulting from starting a
+// certification stripping job while one is running.
+var ErrStripRunning = errgo.New("certification stripping already running")
+
+// stripChunkSize is the number of keys fetched from storage at a time by a
+// stripping job.
+const stripChunkSize = 100
+
+// StripStatus reports the progress of a certification stripping job.
+type StripStatus struct {
+	Running        bool      `json:"running"`
+	DryRun         bool      `json:"dryRun"`
+	Started        time.Time `json:"started"`
+	Finished       time.Time `json:"finished"`
+	Scanned        int       `json:"scanned"`
+	Stripped       int       `json:"stripped"`
+	Certifications int       `json:"certifications"`
+	Errors         int       `json:"errors"`
+	LastError      string    `json:"lastError,omitempty"`
+}
+
+// Stripper rewrites the keys already in storage without their third-party
+// certifications, as a one-off job when stripping is enabled. Each rewritten
+// key is stored with a new digest, and its storage notification replaces the
+// old digest in the prefix tree so that recon partners fetch the new version.
+// st should therefore be the storage to which the recon peer, or the
+// caller's own prefix tree, is subscribed.
+type Stripper struct {
+	st      storage.Storage
+	backend KeyBackend
+
+	mu     sync.Mutex
+	status StripStatus
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewStripper returns a Stripper which rewrites the keys in st, listing them
+// through backend.
+func NewStripper(st storage.Storage, backend KeyBackend) *Stripper {
+	return &Stripper{st: st, backend: backend, stop: make(chan struct{})}
+}
+
+// Close stops any running job and waits for it to finish.
+func (j *Stripper) Close() {
+	close(j.stop)
+	j.wg.Wait()
+}
+
+// Status returns the progress of the running job, or the result of the last
+// job to run.
+func (j *Stripper) Status() StripStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// Start starts a job in the background. If dryRun is true, the keys which
+// would be rewritten are counted, but storage is not changed.
+func (j *Stripper) Start(dryRun bool) error {
+	err := j.begin(dryRun)
+	if err != nil {
+		return errgo.Mask(err, errgo.Is(ErrStripRunning))
+	}
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		j.run(dryRun)
+	}()
+	return nil
+}
+
+// Run runs a job, returning when it is finished.
+func (j *Stripper) Run(dryRun bool) error {
+	err := j.begin(dryRun)
+	if err != nil {
+		return errgo.Mask(err, errgo.Is(ErrStripRunning))
+	}
+	return errgo.Mask(j.run(dryRun))
+}
+
+func (j *Stripper) begin(dryRun bool) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.Running {
+		return errgo.WithCausef(nil, ErrStripRunning, "job started at %s is still running", j.status.Started.Format(time.RFC3339))
+	}
+	j.status = StripStatus{
+		Running: true,
+		DryRun:  dryRun,
+		Started: time.Now().UTC(),
+	}
+	return nil
+}
+
+var errStripStopped = errgo.New("stopped")
+
+func (j *Stripper) run(dryRun bool) error {
+	var rfps []string
+	flush := func() error {
+		keys, err := j.st.FetchKeys(rfps)
+		rfps = rfps[:0]
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		for _, key := range keys {
+			j.strip(key, dryRun)
+		}
+		return nil
+	}
+	err := j.backend.Fingerprints(func(rfp string) error {
+		select {
+		case <-j.stop:
+			return errStripStopped
+		default:
+		}
+		rfps = append(rfps, rfp)
+		if len(rfps) < stripChunkSize {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil && len(rfps) > 0 {
+		err = flush()
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Running = false
+	j.status.Finished = time.Now().UTC()
+	if err != nil {
+		j.status.LastError = err.Error()
+	}
+	log.WithFields(log.Fields{
+		"scanned":        j.status.Scanned,
+		"stripped":       j.status.Stripped,
+		"certifications": j.status.Certifications,
+		"errors":         j.status.Errors,
+		"dryRun":         dryRun,
+	}).Info("certification stripping finished")
+	if errgo.Cause(err) == errStripStopped {
+		return nil
+	}
+	return errgo.Mask(err)
+}
+
+func (j *Stripper) strip(key *openpgp.PrimaryKey, dryRun bool) {
+	n := stripCertifications(key)
+	var err error
+	if n > 0 && !dryRun {
+		priorMD5 := key.MD5
+		key.MD5 = openpgp.SksDigest(key, md5.New())
+		err = j.st.Update(key, priorMD5)
+		if err != nil {
+			log.Errorf("failed to rewrite key %s: %v", key.Fingerprint(), err)
+		}
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Scanned++
+	if n > 0 {
+		j.status.Stripped++
+		j.status.Certifications += n
+	}
+	if err != nil {
+		j.status.Errors++
+		j.status.LastError = err.Error()
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+)
+
+// ErrInvalidFingerprint is the cause of errors resulting from deleting a key
+// by anything other than its full fingerprint.
+var ErrInvalidFingerprint = errgo.New("invalid fingerprint")
+
+// KeyDeleted is the storage key change notified when a key is deleted.
+//
+// The deleted key's digest is left in the prefix tree, so that recon treats
+// the key as already held: partners holding the same copy do not offer it,
+// and it is not fetched from them only to be refused by its tombstone.
+type KeyDeleted struct {
+	Fingerprint string
+	Digest      string
+}
+
+func (kd KeyDeleted) InsertDigests() []string {
+	return nil
+}
+
+func (kd KeyDeleted) RemoveDigests() []string {
+	return nil
+}
+
+func (kd KeyDeleted) String() string {
+	return "key " + kd.Fingerprint + " with digest " + kd.Digest + " deleted"
+}
+
+// KeyRestored is the storage key change notified when the tombstone of a
+// deleted key is removed. The digest left in the prefix tree by its deletion
+// is removed, so that the key is fetched again from partners which hold it.
+type KeyRestored struct {
+	Fingerprint string
+	Digest      string
+}
+
+func (kr KeyRestored) InsertDigests() []string {
+	return nil
+}
+
+func (kr KeyRestored) RemoveDigests() []string {
+	if kr.Digest == "" {
+		return nil
+	}
+	return []string{kr.Digest}
+}
+
+func (kr KeyRestored) String() string {
+	return "key " + kr.Fingerprint + " with digest " + kr.Digest + " restored"
+}
+
+// normalizeFingerprint returns fp in lower-case hex, or an error if it is not
+// a full key fingerprint. Deletion requires full fingerprints so that key ID
+// collisions cannot cause the wrong key to be removed.
+func normalizeFingerprint(fp string) (string, error) {
+	norm := strings.Replace(normalizeKeyID(fp), " ", "", -1)
+	if _, err := hex.DecodeString(norm); err != nil || (len(norm) != 32 && len(norm) != 40 && len(norm) != 64) {
+		return "", errgo.WithCausef(nil, ErrInvalidFingerprint, "%q is not a key fingerprint", fp)
+	}
+	return norm, nil
+}
+
+// Takedown deletes keys from storage, recording a tombstone for each so that
+// it is not accepted again. Deleted keys' digests stay in the prefix tree
+// until they are restored; see KeyDeleted.
+type Takedown struct {
+	st         storage.Storage
+	backend    KeyBackend
+	tombstones *Tombstones
+}
+
+// NewTakedown returns a Takedown which deletes keys from st through
+// backend. The prefix tree is updated by the storage notification of each
+// restoration, so st should be the storage to which the recon peer, or the
+// caller's own prefix tree, is subscribed.
+func NewTakedown(st storage.Storage, backend KeyBackend, tombstones *Tombstones) *Takedown {
+	return &Takedown{st: st, backend: backend, tombstones: tombstones}
+}
+
+// Delete deletes the key with the given fingerprint on behalf of actor. The
+// tombstone is recorded even if the key is not in storage, so that it is
+// refused if it is submitted later. The returned tombstone has an empty
+// Digest in that case.
+func (t *Takedown) Delete(fp, actor, reason string) (*Tombstone, error) {
+	fp, err := normalizeFingerprint(fp)
+	if err != nil {
+		return nil, errgo.Mask(err, errgo.Is(ErrInvalidFingerprint))
+	}
+	ts := &Tombstone{
+		Fingerprint: fp,
+		Time:        time.Now().UTC(),
+		Actor:       actor,
+		Reason:      reason,
+	}
+	// The tombstone is recorded first, so that the key cannot be accepted
+	// again between its deletion and the tombstone being written.
+	err = t.tombstones.Add(ts)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot record tombstone")
+	}
+	ts.Digest, err = t.backend.Delete(ReverseString(fp))
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot delete key %s", fp)
+	}
+	if ts.Digest != "" {
+		err = t.tombstones.Add(ts)
+		if err != nil {
+			return nil, errgo.Notef(err, "cannot record tombstone")
+		}
+		err = t.st.Notify(KeyDeleted{Fingerprint: fp, Digest: ts.Digest})
+		if err != nil {
+			log.Errorf("failed to notify deletion of key %s: %v", fp, err)
+		}
+	}
+	err = t.tombstones.Audit(&TombstoneAudit{
+		Time:        ts.Time,
+		Action:      TombstoneActionDelete,
+		Fingerprint: fp,
+		Digest:      ts.Digest,
+		Actor:       actor,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot write audit log")
+	}
+	log.Infof("key %s deleted by %s: %s", fp, actor, reason)
+	return ts, nil
+}
+
+// Restore removes the tombstone of the key with the given fingerprint on
+// behalf of actor, so that it may be submitted again. The key itself is not
+// restored, but its digest is removed from the prefix tree so that it may be
+// recovered from recon partners.
+func (t *Takedown) Restore(fp, actor, reason string) error {
+	fp, err := normalizeFingerprint(fp)
+	if err != nil {
+		return errgo.Mask(err, errgo.Is(ErrInvalidFingerprint))
+	}
+	ts, err := t.tombstones.Remove(fp)
+	if err != nil {
+		return errgo.Mask(err, errgo.Is(ErrNoTombstone))
+	}
+	if ts.Digest != "" {
+		err = t.st.Notify(KeyRestored{Fingerprint: fp, Digest: ts.Digest})
+		if err != nil {
+			log.Errorf("failed to notify restoration of key %s: %v", fp, err)
+		}
+	}
+	err = t.tombstones.Audit(&TombstoneAudit{
+		Time:        time.Now().UTC(),
+		Action:      TombstoneActionRestore,
+		Fingerprint: fp,
+		Digest:      ts.Digest,
+		Actor:       actor,
+		Reason:      reason,
+	})
+	if err != nil {
+		return errgo.Notef(err, "cannot write audit log")
+	}
+	log.Infof("key %s restored by %s: %s", fp, actor, reason)
+	return nil
+}
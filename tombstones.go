@@ -0,0 +1,302 @@
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+var (
+	// ErrKeyDeleted is the cause of errors resulting from submitting a key
+	// which has been deleted.
+	ErrKeyDeleted = errgo.New("key deleted")
+
+	// ErrNoTombstone is the cause of errors resulting from restoring a key
+	// which has not been deleted.
+	ErrNoTombstone = errgo.New("no tombstone")
+)
+
+// tombstonesRefreshInterval is how often the tombstones file is checked for
+// changes made by other processes, such as the takedown command.
+const tombstonesRefreshInterval = 5 * time.Second
+
+// Tombstone records the deletion of a key.
+type Tombstone struct {
+	Fingerprint string    `json:"fingerprint"`
+	Digest      string    `json:"digest,omitempty"`
+	Time        time.Time `json:"time"`
+	Actor       string    `json:"actor"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+// TombstoneAudit is an entry in the audit log of key deletions.
+type TombstoneAudit struct {
+	Time        time.Time `json:"time"`
+	Action      string    `json:"action"`
+	Fingerprint string    `json:"fingerprint"`
+	Digest      string    `json:"digest,omitempty"`
+	Actor       string    `json:"actor"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+// Audited actions.
+const (
+	TombstoneActionDelete  = "delete"
+	TombstoneActionRestore = "restore"
+)
+
+// Tombstones is the set of deleted keys, which are refused by storage
+// obtained from its Storage method. The set is kept in a file shared by
+// every process using the same settings, so that keys deleted by one are
+// refused by all.
+type Tombstones struct {
+	path     string
+	auditLog string
+
+	mu        sync.Mutex
+	byFP      map[string]*Tombstone
+	modTime   time.Time
+	size      int64
+	refreshed time.Time
+}
+
+// OpenTombstones opens the set of deleted keys.
+func OpenTombstones(settings *TombstonesConfig) (*Tombstones, error) {
+	t := &Tombstones{
+		path:     settings.File,
+		auditLog: settings.AuditLog,
+		byFP:     make(map[string]*Tombstone),
+	}
+	err := t.withLock(t.load)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot open tombstones %q", t.path)
+	}
+	return t, nil
+}
+
+func (t *Tombstones) withLock(f func() error) error {
+	lock, err := os.OpenFile(t.path+".lock", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	defer lock.Close()
+	err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		return errgo.Notef(err, "cannot lock tombstones")
+	}
+	defer syscall.Flock(int(lock.Fd()), syscall.LOCK_UN)
+	return f()
+}
+
+// load reads the tombstones file if it has changed since it was last read.
+// It must be called with t.mu held, or before t is in use.
+func (t *Tombstones) load() error {
+	t.refreshed = time.Now()
+	fi, err := os.Stat(t.path)
+	if os.IsNotExist(err) {
+		t.byFP = make(map[string]*Tombstone)
+		t.modTime, t.size = time.Time{}, 0
+		return nil
+	} else if err != nil {
+		return errgo.Mask(err)
+	}
+	if fi.ModTime().Equal(t.modTime) && fi.Size() == t.size {
+		return nil
+	}
+	buf, err := ioutil.ReadFile(t.path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	var tombstones []*Tombstone
+	err = json.Unmarshal(buf, &tombstones)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	byFP := make(map[string]*Tombstone)
+	for _, ts := range tombstones {
+		byFP[ts.Fingerprint] = ts
+	}
+	t.byFP, t.modTime, t.size = byFP, fi.ModTime(), fi.Size()
+	return nil
+}
+
+// save writes the tombstones file. It must be called with t.mu and the file
+// lock held.
+func (t *Tombstones) save() error {
+	buf, err := json.MarshalIndent(t.list(), "", "  ")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = ioutil.WriteFile(t.path+".tmp", buf, 0644)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = os.Rename(t.path+".tmp", t.path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	fi, err := os.Stat(t.path)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	t.modTime, t.size = fi.ModTime(), fi.Size()
+	return nil
+}
+
+func (t *Tombstones) list() []*Tombstone {
+	result := make([]*Tombstone, 0, len(t.byFP))
+	for _, ts := range t.byFP {
+		result = append(result, ts)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Fingerprint < result[j].Fingerprint })
+	return result
+}
+
+// refresh picks up changes made to the tombstones file by other processes.
+// It must be called with t.mu held.
+func (t *Tombstones) refresh() {
+	if time.Since(t.refreshed) < tombstonesRefreshInterval {
+		return
+	}
+	err := t.withLock(t.load)
+	if err != nil {
+		log.Errorf("failed to reload tombstones %q: %v", t.path, err)
+	}
+}
+
+// List returns the tombstones, ordered by fingerprint.
+func (t *Tombstones) List() []Tombstone {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refresh()
+	var result []Tombstone
+	for _, ts := range t.list() {
+		result = append(result, *ts)
+	}
+	return result
+}
+
+// Get returns the tombstone of the key with the given fingerprint, if it has
+// been deleted.
+func (t *Tombstones) Get(fp string) (*Tombstone, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refresh()
+	ts, ok := t.byFP[normalizeKeyID(fp)]
+	if !ok {
+		return nil, false
+	}
+	result := *ts
+	return &result, true
+}
+
+// Add records a tombstone, replacing any earlier one for the same key.
+func (t *Tombstones) Add(ts *Tombstone) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.withLock(func() error {
+		err := t.load()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		t.byFP[ts.Fingerprint] = ts
+		err = t.save()
+		if err != nil {
+			delete(t.byFP, ts.Fingerprint)
+			return errgo.Mask(err)
+		}
+		return nil
+	})
+}
+
+// Remove removes the tombstone of the key with the given fingerprint,
+// returning it.
+func (t *Tombstones) Remove(fp string) (*Tombstone, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fp = normalizeKeyID(fp)
+	var ts *Tombstone
+	err := t.withLock(func() error {
+		err := t.load()
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		var ok bool
+		ts, ok = t.byFP[fp]
+		if !ok {
+			return errgo.WithCausef(nil, ErrNoTombstone, "key %q has not been deleted", fp)
+		}
+		delete(t.byFP, fp)
+		err = t.save()
+		if err != nil {
+			t.byFP[fp] = ts
+			return errgo.Mask(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errgo.Mask(err, errgo.Is(ErrNoTombstone))
+	}
+	return ts, nil
+}
+
+// Audit appends an entry to the audit log.
+func (t *Tombstones) Audit(entry *TombstoneAudit) error {
+	buf, err := json.Marshal(entry)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	f, err := os.OpenFile(t.auditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	_, err = f.Write(append(buf, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return errgo.Mask(err)
+}
+
+// AuditLog calls f with each entry in the audit log, oldest first.
+func (t *Tombstones) AuditLog(f func(*TombstoneAudit) error) error {
+	file, err := os.Open(t.auditLog)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errgo.Mask(err)
+	}
+	defer file.Close()
+	dec := json.NewDecoder(file)
+	for dec.More() {
+		var entry TombstoneAudit
+		err := dec.Decode(&entry)
+		if err != nil {
+			return errgo.Notef(err, "invalid audit log %q", t.auditLog)
+		}
+		err = f(&entry)
+		if err != nil {
+			return errgo.Mask(err, errgo.Any)
+		}
+	}
+	return nil
+}
+
+// Check refuses deleted keys, so that they are not accepted again from
+// submissions or recon partners. Recon partners only offer a deleted key if
+// their copy differs from the one deleted, whose digest stays in the prefix
+// tree; see KeyDeleted.
+func (t *Tombstones) Check(key *openpgp.PrimaryKey, source string) error {
+	if _, ok := t.Get(key.Fingerprint()); !ok {
+		return nil
+	}
+	log.Infof("refused deleted key %s from %s", key.Fingerprint(), source)
+	return errgo.WithCausef(nil, ErrKeyDeleted, "key %s has been deleted", key.Fingerprint())
+}
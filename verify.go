@@ -0,0 +1,801 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/mail"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+)
+
+var (
+	// ErrTokenInvalid is the cause of errors resulting from following a
+	// verification or management link which is unknown or has expired.
+	ErrTokenInvalid = errgo.New("invalid or expired token")
+
+	// ErrNoSuchUserID is the cause of errors resulting from requesting
+	// verification of an email address which is not in a user ID of the
+	// key.
+	ErrNoSuchUserID = errgo.New("no such user ID")
+
+	// ErrVerificationDisabled is the cause of errors resulting from
+	// managing verifications when email verification is not enabled.
+	ErrVerificationDisabled = errgo.New("email verification not enabled")
+)
+
+// Kinds of verification.
+const (
+	// verificationPublish confirms that the owner of an email address
+	// agrees to its publication in a user ID of a key. It is published
+	// once verified, until it is revoked.
+	verificationPublish = "publish"
+
+	// verificationManage authorizes the owner of an email address to
+	// revoke its publication.
+	verificationManage = "manage"
+
+	// verificationUpload authorizes the uploader of a key through the VKS
+	// API to request verification of its addresses.
+	verificationUpload = "upload"
+)
+
+// Publication states of an email address, as reported by the VKS API.
+const (
+	PublicationUnpublished = "unpublished"
+	PublicationPending     = "pending"
+	PublicationPublished   = "published"
+)
+
+const (
+	// verificationResendInterval is how long after a verification or
+	// management link is mailed before another is sent for the same
+	// address, so that the server cannot be used to flood it with mail.
+	verificationResendInterval = time.Hour
+
+	// verificationExpireInterval is how often expired links are deleted
+	// from storage.
+	verificationExpireInterval = time.Hour
+
+	// verificationQueueSize is the number of submitted keys which may be
+	// awaiting verification mails.
+	verificationQueueSize = 1000
+)
+
+// Verification is the state of the publication of an email address in a
+// user ID of a key, or of a request to manage it. Verified is the zero time
+// until the link mailed to the address is followed.
+type Verification struct {
+	Token       string    `json:"-"`
+	Kind        string    `json:"kind"`
+	Fingerprint string    `json:"fingerprint,omitempty"`
+	Email       string    `json:"email"`
+	Created     time.Time `json:"created"`
+	Verified    time.Time `json:"verified"`
+}
+
+func newVerificationToken() (string, error) {
+	buf := make([]byte, 20)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Verifier publishes the user IDs of keys only once the owners of their
+// email addresses have confirmed them. Keys submitted over HKP cause a
+// verification link to be mailed to each address; lookups are served from a
+// view of storage without the user IDs which have not been verified.
+//
+// Keys are stored and reconciled whole; verification only affects what is
+// served by lookups.
+type Verifier struct {
+	settings  *VerificationConfig
+	st        storage.Storage
+	backend   KeyBackend
+	source    func(digest string) string
+	submitted chan string
+
+	mu          sync.Mutex
+	subscribers []func(fp string)
+
+	t tomb.Tomb
+}
+
+// NewVerifier returns a Verifier for the keys in st, keeping verifications
+// in backend. source returns the source of a change to storage, so that
+// verification is only requested for keys submitted over HKP.
+func NewVerifier(settings *Settings, st storage.Storage, backend KeyBackend, source func(digest string) string) (*Verifier, error) {
+	err := backend.InitVerifications()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	v := &Verifier{
+		settings:  settings.OpenPGP.Verification,
+		st:        st,
+		backend:   backend,
+		source:    source,
+		submitted: make(chan string, verificationQueueSize),
+	}
+	st.Subscribe(v.keyChanged)
+	return v, nil
+}
+
+// SubscribePublications registers f to be called with the fingerprint of a
+// key whenever the publication of its user IDs changes.
+func (v *Verifier) SubscribePublications(f func(fp string)) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.subscribers = append(v.subscribers, f)
+}
+
+func (v *Verifier) publicationChanged(fp string) {
+	v.mu.Lock()
+	subscribers := v.subscribers
+	v.mu.Unlock()
+	for _, f := range subscribers {
+		f(fp)
+	}
+}
+
+func (v *Verifier) Start() {
+	v.t.Go(v.loop)
+}
+
+func (v *Verifier) Stop() error {
+	v.t.Kill(nil)
+	return errgo.Mask(v.t.Wait())
+}
+
+func (v *Verifier) keyChanged(kc storage.KeyChange) error {
+	_, _, digest, ok := describeKeyChange(kc)
+	if !ok || digest == "" || v.source(digest) != SourceHKP {
+		return nil
+	}
+	select {
+	case v.submitted <- digest:
+	default:
+		log.Warningf("verification queue full, not mailing verification links for key with digest %s", digest)
+	}
+	return nil
+}
+
+func (v *Verifier) loop() error {
+	ticker := time.NewTicker(verificationExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-v.t.Dying():
+			return nil
+		case digest := <-v.submitted:
+			v.requestDigest(digest)
+		case <-ticker.C:
+			n, err := v.backend.ExpireVerifications(time.Now().Add(-v.ttl()))
+			if err != nil {
+				log.Errorf("failed to delete expired verifications: %v", err)
+			} else if n > 0 {
+				log.Infof("deleted %d expired verifications", n)
+			}
+		}
+	}
+}
+
+func (v *Verifier) ttl() time.Duration {
+	return time.Duration(v.settings.TokenTTLHours) * time.Hour
+}
+
+// requestDigest mails verification links for each of the email addresses
+// of the key with the given digest.
+func (v *Verifier) requestDigest(digest string) {
+	rfps, err := v.st.MatchMD5([]string{digest})
+	if err != nil {
+		log.Errorf("failed to find key with digest %s: %v", digest, err)
+		return
+	}
+	keys, err := v.st.FetchKeys(rfps)
+	if err != nil {
+		log.Errorf("failed to fetch key with digest %s: %v", digest, err)
+		return
+	}
+	for _, key := range keys {
+		requested := make(map[string]bool)
+		for _, uid := range key.UserIDs {
+			email := uidEmail(uid.Keywords)
+			if email == "" || requested[email] {
+				continue
+			}
+			requested[email] = true
+			err := v.request(key, email)
+			if err != nil {
+				log.Errorf("failed to request verification of %q for key %s: %v", email, key.Fingerprint(), err)
+			}
+		}
+	}
+}
+
+// Request mails a verification link to an email address in a user ID of the
+// key with the given fingerprint.
+func (v *Verifier) Request(fp, email string) error {
+	fp, err := normalizeFingerprint(fp)
+	if err != nil {
+		return errgo.Mask(err, errgo.Is(ErrInvalidFingerprint))
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	keys, err := v.st.FetchKeys([]string{ReverseString(fp)})
+	if err != nil && !storage.IsNotFound(err) {
+		return errgo.Mask(err)
+	}
+	for _, key := range keys {
+		for _, uid := range key.UserIDs {
+			if uidEmail(uid.Keywords) == email {
+				return errgo.Mask(v.request(key, email), errgo.Is(errBadRequest))
+			}
+		}
+	}
+	return errgo.WithCausef(nil, ErrNoSuchUserID, "key %s has no user ID with address %q", fp, email)
+}
+
+// request mails a verification link to email for key, unless it is already
+// published or a link was mailed to it recently.
+func (v *Verifier) request(key *openpgp.PrimaryKey, email string) error {
+	if addr, err := mail.ParseAddress(email); err != nil || strings.ToLower(addr.Address) != email {
+		return errgo.WithCausef(nil, errBadRequest, "cannot verify invalid address %q", email)
+	}
+	fp := key.Fingerprint()
+	existing, err := v.backend.Verifications(verificationPublish, fp, email)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for _, ver := range existing {
+		if !ver.Verified.IsZero() || time.Since(ver.Created) < verificationResendInterval {
+			return nil
+		}
+	}
+	token, err := newVerificationToken()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = v.backend.PutVerification(&Verification{
+		Token:       token,
+		Kind:        verificationPublish,
+		Fingerprint: fp,
+		Email:       email,
+		Created:     time.Now().UTC(),
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = v.mail(email, "Verify your email address for OpenPGP key "+fp, fmt.Sprintf(`Someone, hopefully you, submitted the OpenPGP key
+
+  %s
+
+with a user ID containing your email address, %s.
+
+To publish your address with the key, follow this link within %d hours:
+
+  %s
+
+If you did not submit this key, ignore this mail and your address will not
+be published.
+`, fp, email, v.settings.TokenTTLHours, v.link("/pks/verify/", token)))
+	if err != nil {
+		return errgo.Notef(err, "cannot mail verification link")
+	}
+	log.Infof("mailed verification link for %q on key %s", email, fp)
+	return nil
+}
+
+// RequestManage mails a link to an email address through which its
+// publication may be revoked. Nothing is mailed if the address is not
+// published, so as not to reveal whether it is.
+func (v *Verifier) RequestManage(email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	published, err := v.publishedFor("", email)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	if len(published) == 0 {
+		return nil
+	}
+	existing, err := v.backend.Verifications(verificationManage, "", email)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for _, ver := range existing {
+		if time.Since(ver.Created) < verificationResendInterval {
+			return nil
+		}
+	}
+	token, err := newVerificationToken()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = v.backend.PutVerification(&Verification{
+		Token:   token,
+		Kind:    verificationManage,
+		Email:   email,
+		Created: time.Now().UTC(),
+	})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	err = v.mail(email, "Manage your published email address", fmt.Sprintf(`Someone, hopefully you, asked to manage the publication of your email
+address, %s, with OpenPGP keys.
+
+To see the keys it is published with, and revoke its publication, follow
+this link within %d hours:
+
+  %s
+
+If you did not ask for this, ignore this mail.
+`, email, v.settings.TokenTTLHours, v.link("/pks/manage/", token)))
+	if err != nil {
+		return errgo.Notef(err, "cannot mail management link")
+	}
+	log.Infof("mailed management link for %q", email)
+	return nil
+}
+
+func (v *Verifier) link(path, token string) string {
+	return strings.TrimRight(v.settings.BaseURL, "/") + path + token
+}
+
+func (v *Verifier) mail(to, subject, body string) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", v.settings.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Auto-Submitted: auto-generated\r\n")
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.Replace(body, "\n", "\r\n", -1))
+	return errgo.Mask(sendMail(&v.settings.SMTP, v.settings.From, to, msg.Bytes()))
+}
+
+// valid returns the unexpired verification of the given kind with token.
+func (v *Verifier) valid(token, kind string) (*Verification, error) {
+	ver, err := v.backend.Verification(token)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	if ver == nil || ver.Kind != kind || (ver.Verified.IsZero() && time.Since(ver.Created) > v.ttl()) {
+		return nil, errgo.WithCausef(nil, ErrTokenInvalid, "invalid or expired token")
+	}
+	return ver, nil
+}
+
+// Confirm publishes the email address verified by token.
+func (v *Verifier) Confirm(token string) (*Verification, error) {
+	ver, err := v.valid(token, verificationPublish)
+	if err != nil {
+		return nil, errgo.Mask(err, errgo.Is(ErrTokenInvalid))
+	}
+	if !ver.Verified.IsZero() {
+		return ver, nil
+	}
+	ver.Verified = time.Now().UTC()
+	err = v.backend.PutVerification(ver)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	log.Infof("verified %q on key %s", ver.Email, ver.Fingerprint)
+	v.publicationChanged(ver.Fingerprint)
+	return ver, nil
+}
+
+// Managed returns the email address whose publication may be managed with
+// token, and the fingerprints of the keys with which it is published.
+func (v *Verifier) Managed(token string) (string, []string, error) {
+	ver, err := v.valid(token, verificationManage)
+	if err != nil {
+		return "", nil, errgo.Mask(err, errgo.Is(ErrTokenInvalid))
+	}
+	published, err := v.publishedFor("", ver.Email)
+	if err != nil {
+		return "", nil, errgo.Mask(err)
+	}
+	seen := make(map[string]bool)
+	var fps []string
+	for _, p := range published {
+		if !seen[p.Fingerprint] {
+			seen[p.Fingerprint] = true
+			fps = append(fps, p.Fingerprint)
+		}
+	}
+	sort.Strings(fps)
+	return ver.Email, fps, nil
+}
+
+// UploadToken returns a token with which the uploader of the key with the
+// given fingerprint may request verification of its addresses.
+func (v *Verifier) UploadToken(fp string) (string, error) {
+	token, err := newVerificationToken()
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	err = v.backend.PutVerification(&Verification{
+		Token:       token,
+		Kind:        verificationUpload,
+		Fingerprint: fp,
+		Created:     time.Now().UTC(),
+	})
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	return token, nil
+}
+
+// Uploaded returns the fingerprint of the key uploaded with token.
+func (v *Verifier) Uploaded(token string) (string, error) {
+	ver, err := v.valid(token, verificationUpload)
+	if err != nil {
+		return "", errgo.Mask(err, errgo.Is(ErrTokenInvalid))
+	}
+	return ver.Fingerprint, nil
+}
+
+// Publication returns the publication state of each email address in the
+// user IDs of key.
+func (v *Verifier) Publication(key *openpgp.PrimaryKey) (map[string]string, error) {
+	vers, err := v.backend.Verifications(verificationPublish, key.Fingerprint(), "")
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	result := make(map[string]string)
+	for _, uid := range key.UserIDs {
+		if email := uidEmail(uid.Keywords); email != "" {
+			result[email] = PublicationUnpublished
+		}
+	}
+	for _, ver := range vers {
+		if _, ok := result[ver.Email]; !ok {
+			continue
+		}
+		if !ver.Verified.IsZero() {
+			result[ver.Email] = PublicationPublished
+		} else if time.Since(ver.Created) <= v.ttl() && result[ver.Email] != PublicationPublished {
+			result[ver.Email] = PublicationPending
+		}
+	}
+	return result, nil
+}
+
+// Verifications returns the verifications of the email addresses of the key
+// with the given fingerprint, whether published or awaiting verification.
+func (v *Verifier) Verifications(fp string) ([]*Verification, error) {
+	fp, err := normalizeFingerprint(fp)
+	if err != nil {
+		return nil, errgo.Mask(err, errgo.Is(ErrInvalidFingerprint))
+	}
+	vers, err := v.backend.Verifications(verificationPublish, fp, "")
+	return vers, errgo.Mask(err)
+}
+
+// Revoke revokes the publication of an email address with the key with the
+// given fingerprint, or of all of its addresses if email is empty, on behalf
+// of actor. It returns the number of verifications removed.
+func (v *Verifier) Revoke(fp, email, actor string) (int, error) {
+	fp, err := normalizeFingerprint(fp)
+	if err != nil {
+		return 0, errgo.Mask(err, errgo.Is(ErrInvalidFingerprint))
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	n, err := v.backend.DeleteVerifications(verificationPublish, fp, email)
+	if err != nil {
+		return 0, errgo.Mask(err)
+	}
+	if n > 0 && email == "" {
+		log.Infof("publication of all addresses on key %s revoked by %s", fp, actor)
+	} else if n > 0 {
+		log.Infof("publication of %q on key %s revoked by %s", email, fp, actor)
+	}
+	if n > 0 {
+		v.publicationChanged(fp)
+	}
+	return n, nil
+}
+
+// publishedFor returns the verified publications for a fingerprint or email
+// address.
+func (v *Verifier) publishedFor(fp, email string) ([]*Verification, error) {
+	vers, err := v.backend.Verifications(verificationPublish, fp, email)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var result []*Verification
+	for _, ver := range vers {
+		if !ver.Verified.IsZero() {
+			result = append(result, ver)
+		}
+	}
+	return result, nil
+}
+
+// filterKey removes the user IDs of key whose email addresses are not
+// published, and its user attributes, which cannot be verified.
+func (v *Verifier) filterKey(key *openpgp.PrimaryKey) error {
+	published, err := v.publishedFor(key.Fingerprint(), "")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	emails := make(map[string]bool)
+	for _, ver := range published {
+		emails[ver.Email] = true
+	}
+	var uids []*openpgp.UserID
+	for _, uid := range key.UserIDs {
+		if email := uidEmail(uid.Keywords); email != "" && emails[email] {
+			uids = append(uids, uid)
+		}
+	}
+	key.UserIDs = uids
+	key.UserAttributes = nil
+	return nil
+}
+
+// Storage returns a view of st without the user IDs which have not been
+// verified, from which lookups are served.
+func (v *Verifier) Storage(st storage.Storage) storage.Storage {
+	return &verifiedStorage{Storage: st, v: v}
+}
+
+type verifiedStorage struct {
+	storage.Storage
+	v *Verifier
+}
+
+func (st *verifiedStorage) FetchKeys(rfps []string) ([]*openpgp.PrimaryKey, error) {
+	keys, err := st.Storage.FetchKeys(rfps)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		err := st.v.filterKey(key)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+	}
+	return keys, nil
+}
+
+func (st *verifiedStorage) FetchKeyrings(rfps []string) ([]*storage.Keyring, error) {
+	keyrings, err := st.Storage.FetchKeyrings(rfps)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyring := range keyrings {
+		err := st.v.filterKey(keyring.PrimaryKey)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+	}
+	return keyrings, nil
+}
+
+// MatchKeyword only matches keys by their published user IDs, so that
+// searching for an unpublished address does not reveal the keys using it.
+func (st *verifiedStorage) MatchKeyword(search []string) ([]string, error) {
+	rfps, err := st.Storage.MatchKeyword(search)
+	if err != nil || len(rfps) == 0 {
+		return rfps, err
+	}
+	keys, err := st.FetchKeys(rfps)
+	if err != nil {
+		return nil, err
+	}
+	match := matchKeywords
+	if m, ok := st.Storage.(userIDMatcher); ok {
+		match = m.MatchUserIDs
+	}
+	matched := make(map[string]bool)
+	for _, key := range keys {
+		var uids []string
+		for _, uid := range key.UserIDs {
+			uids = append(uids, uid.Keywords)
+		}
+		if match(search, uids) {
+			matched[key.RFingerprint] = true
+		}
+	}
+	var result []string
+	for _, rfp := range rfps {
+		if matched[rfp] {
+			result = append(result, rfp)
+		}
+	}
+	return result, nil
+}
+
+// matchKeywords returns whether any of the terms in search is contained in
+// any of the given user IDs.
+func matchKeywords(search []string, uids []string) bool {
+	for _, uid := range uids {
+		keywords := strings.ToLower(uid)
+		for _, term := range search {
+			if strings.Contains(keywords, strings.ToLower(term)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifyPage is the content of the pages served to those following
+// verification and management links.
+type verifyPage struct {
+	Title   string
+	Message string
+	Forms   []verifyForm
+}
+
+// verifyForm is a form posted back to the page on which it is shown.
+type verifyForm struct {
+	Label  string
+	Button string
+	Fields map[string]string
+}
+
+var verifyTemplate = template.Must(template.New("verify").Parse(`<!DOCTYPE html>
+<html>
+<head><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+{{range .Forms}}<form method="post">
+{{range $name, $value := .Fields}}<input type="hidden" name="{{$name}}" value="{{$value}}">
+{{end}}{{if .Label}}<code>{{.Label}}</code> {{end}}<button type="submit">{{.Button}}</button>
+</form>
+{{end}}</body>
+</html>
+`))
+
+func serveVerifyPage(w http.ResponseWriter, status int, page *verifyPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	err := verifyTemplate.Execute(w, page)
+	if err != nil {
+		log.Errorf("failed to write verification page: %v", err)
+	}
+}
+
+func verifyFail(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	message := "Internal error."
+	switch errgo.Cause(err) {
+	case ErrTokenInvalid:
+		status, message = http.StatusNotFound, "This link is invalid or has expired."
+	case ErrNoSuchUserID:
+		status, message = http.StatusNotFound, err.Error()
+	case errBadRequest, ErrInvalidFingerprint:
+		status, message = http.StatusBadRequest, err.Error()
+	default:
+		log.Errorf("verification: %v", errgo.Details(err))
+	}
+	serveVerifyPage(w, status, &verifyPage{Title: "Error", Message: message})
+}
+
+// Register registers the handlers of verification and management requests
+// and links.
+func (v *Verifier) Register(r *httprouter.Router) {
+	r.POST("/pks/verify", v.serveRequest)
+	r.GET("/pks/verify/:token", v.serveConfirmForm)
+	r.POST("/pks/verify/:token", v.serveConfirm)
+	r.POST("/pks/manage", v.serveManageRequest)
+	r.GET("/pks/manage/:token", v.serveManage)
+	r.POST("/pks/manage/:token", v.serveRevoke)
+}
+
+// serveRequest mails a verification link for the fingerprint and email
+// form values.
+func (v *Verifier) serveRequest(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	err := v.Request(req.FormValue("fingerprint"), req.FormValue("email"))
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	serveVerifyPage(w, http.StatusAccepted, &verifyPage{
+		Title:   "Verification requested",
+		Message: "A verification link has been mailed to the address, unless it is already published or one was sent recently.",
+	})
+}
+
+// serveConfirmForm asks for confirmation before publishing, so that links
+// fetched by mail scanners do not publish addresses.
+func (v *Verifier) serveConfirmForm(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	ver, err := v.valid(ps.ByName("token"), verificationPublish)
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	serveVerifyPage(w, http.StatusOK, &verifyPage{
+		Title:   "Publish email address",
+		Message: fmt.Sprintf("Publish %s with OpenPGP key %s?", ver.Email, ver.Fingerprint),
+		Forms:   []verifyForm{{Button: "Publish"}},
+	})
+}
+
+func (v *Verifier) serveConfirm(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	ver, err := v.Confirm(ps.ByName("token"))
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	serveVerifyPage(w, http.StatusOK, &verifyPage{
+		Title:   "Email address published",
+		Message: fmt.Sprintf("%s is now published with OpenPGP key %s.", ver.Email, ver.Fingerprint),
+	})
+}
+
+// serveManageRequest mails a management link to the email form value.
+func (v *Verifier) serveManageRequest(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	err := v.RequestManage(req.FormValue("email"))
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	serveVerifyPage(w, http.StatusAccepted, &verifyPage{
+		Title:   "Management link requested",
+		Message: "If the address is published, a link to manage it has been mailed to it.",
+	})
+}
+
+func (v *Verifier) serveManage(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	v.serveManagePage(w, ps.ByName("token"), "")
+}
+
+func (v *Verifier) serveManagePage(w http.ResponseWriter, token, message string) {
+	email, fps, err := v.Managed(token)
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	page := &verifyPage{Title: "Manage " + email}
+	if message != "" {
+		page.Message = message + " "
+	}
+	if len(fps) == 0 {
+		page.Message += "The address is not published with any keys."
+	} else {
+		page.Message += "The address is published with these keys:"
+	}
+	for _, fp := range fps {
+		page.Forms = append(page.Forms, verifyForm{
+			Label:  fp,
+			Button: "Revoke",
+			Fields: map[string]string{"fingerprint": fp},
+		})
+	}
+	serveVerifyPage(w, http.StatusOK, page)
+}
+
+// serveRevoke revokes the publication of the managed address with the key
+// given by the fingerprint form value.
+func (v *Verifier) serveRevoke(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	token := ps.ByName("token")
+	email, _, err := v.Managed(token)
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	fp := req.FormValue("fingerprint")
+	_, err = v.Revoke(fp, email, email)
+	if err != nil {
+		verifyFail(w, err)
+		return
+	}
+	v.serveManagePage(w, token, fmt.Sprintf("Publication with key %s revoked.", fp))
+}
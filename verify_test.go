@@ -0,0 +1,278 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+const testVerifyFingerprint = "0123456789abcdef0123456789abcdef01234567"
+
+// testKeyBackend holds verifications in memory. Methods not needed by the
+// tests panic through the nil embedded KeyBackend.
+type testKeyBackend struct {
+	KeyBackend
+	vers map[string]*Verification
+}
+
+func (b *testKeyBackend) PutVerification(v *Verification) error {
+	copied := *v
+	b.vers[v.Token] = &copied
+	return nil
+}
+
+func (b *testKeyBackend) Verification(token string) (*Verification, error) {
+	v, ok := b.vers[token]
+	if !ok {
+		return nil, nil
+	}
+	copied := *v
+	return &copied, nil
+}
+
+func (b *testKeyBackend) matches(v *Verification, kind, fp, email string) bool {
+	return v.Kind == kind && (fp == "" || v.Fingerprint == fp) && (email == "" || v.Email == email)
+}
+
+func (b *testKeyBackend) Verifications(kind, fp, email string) ([]*Verification, error) {
+	var result []*Verification
+	for _, v := range b.vers {
+		if b.matches(v, kind, fp, email) {
+			copied := *v
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (b *testKeyBackend) DeleteVerifications(kind, fp, email string) (int, error) {
+	var n int
+	for token, v := range b.vers {
+		if b.matches(v, kind, fp, email) {
+			delete(b.vers, token)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func newTestVerifier(st *testStorage, smtpAddr string) (*Verifier, *testKeyBackend) {
+	backend := &testKeyBackend{vers: make(map[string]*Verification)}
+	return &Verifier{
+		settings: &VerificationConfig{
+			From:          "keyserver@example.com",
+			SMTP:          SMTPConfig{Host: smtpAddr},
+			BaseURL:       "https://keys.example.com/",
+			TokenTTLHours: 1,
+		},
+		st:      st,
+		backend: backend,
+	}, backend
+}
+
+func newTestVerifyKey() *openpgp.PrimaryKey {
+	key := &openpgp.PrimaryKey{
+		UserIDs: []*openpgp.UserID{
+			{Keywords: "Alice <Alice@example.com>"},
+			{Keywords: "Bob <bob@example.com>"},
+		},
+	}
+	key.RFingerprint = ReverseString(testVerifyFingerprint)
+	return key
+}
+
+func userIDKeywords(key *openpgp.PrimaryKey) []string {
+	var result []string
+	for _, uid := range key.UserIDs {
+		result = append(result, uid.Keywords)
+	}
+	return result
+}
+
+// mailedToken returns the token of the link under path in a mail.
+func mailedToken(t *testing.T, mail testMail, path string) string {
+	m := regexp.MustCompile(regexp.QuoteMeta("https://keys.example.com"+path) + `([0-9a-f]+)`).FindStringSubmatch(mail.data)
+	if m == nil {
+		t.Fatalf("no %s link in mail %q", path, mail.data)
+	}
+	return m[1]
+}
+
+func TestVerifyRequestConfirm(t *testing.T) {
+	srv := newTestSMTPServer(t)
+	defer srv.Close()
+	key := newTestVerifyKey()
+	v, backend := newTestVerifier(newTestStorage(key), srv.Addr())
+
+	err := v.Request(testVerifyFingerprint, "Alice@Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mail := srv.nextMail(t)
+	if len(mail.to) != 1 || mail.to[0] != "alice@example.com" {
+		t.Errorf("got mail to %q, want alice@example.com", mail.to)
+	}
+	token := mailedToken(t, mail, "/pks/verify/")
+
+	// Another link is not mailed until the resend interval has passed.
+	err = v.Request(testVerifyFingerprint, "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.vers) != 1 {
+		t.Errorf("got %d verifications after a repeated request, want 1", len(backend.vers))
+	}
+
+	filtered := *key
+	v.filterKey(&filtered)
+	if len(filtered.UserIDs) != 0 {
+		t.Errorf("unverified user IDs served: %q", userIDKeywords(&filtered))
+	}
+
+	// Following the link only asks for confirmation.
+	ps := httprouter.Params{{Key: "token", Value: token}}
+	rec := httptest.NewRecorder()
+	v.serveConfirmForm(rec, httptest.NewRequest("GET", "/pks/verify/"+token, nil), ps)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "<form") {
+		t.Fatalf("got %d %q, want a confirmation form", rec.Code, rec.Body.String())
+	}
+	if published, _ := v.publishedFor(testVerifyFingerprint, ""); len(published) != 0 {
+		t.Fatal("address published without confirmation")
+	}
+
+	rec = httptest.NewRecorder()
+	v.serveConfirm(rec, httptest.NewRequest("POST", "/pks/verify/"+token, nil), ps)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %q confirming", rec.Code, rec.Body.String())
+	}
+	filtered = *key
+	v.filterKey(&filtered)
+	if uids := userIDKeywords(&filtered); len(uids) != 1 || uids[0] != "Alice <Alice@example.com>" {
+		t.Errorf("got user IDs %q, want only the verified one", uids)
+	}
+
+	rec = httptest.NewRecorder()
+	v.serveConfirm(rec, httptest.NewRequest("POST", "/pks/verify/bogus", nil), httprouter.Params{{Key: "token", Value: "bogus"}})
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("got %d for an invalid token, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestVerifyManageRevoke(t *testing.T) {
+	srv := newTestSMTPServer(t)
+	defer srv.Close()
+	key := newTestVerifyKey()
+	v, backend := newTestVerifier(newTestStorage(key), srv.Addr())
+	backend.PutVerification(&Verification{
+		Token:       "published",
+		Kind:        verificationPublish,
+		Fingerprint: testVerifyFingerprint,
+		Email:       "alice@example.com",
+		Created:     time.Now().UTC(),
+		Verified:    time.Now().UTC(),
+	})
+	var changed []string
+	v.SubscribePublications(func(fp string) {
+		changed = append(changed, fp)
+	})
+
+	form := url.Values{"email": {"alice@example.com"}}
+	req := httptest.NewRequest("POST", "/pks/manage", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	v.serveManageRequest(rec, req, nil)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("got %d %q requesting management", rec.Code, rec.Body.String())
+	}
+	token := mailedToken(t, srv.nextMail(t), "/pks/manage/")
+
+	ps := httprouter.Params{{Key: "token", Value: token}}
+	rec = httptest.NewRecorder()
+	v.serveManage(rec, httptest.NewRequest("GET", "/pks/manage/"+token, nil), ps)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), testVerifyFingerprint) {
+		t.Fatalf("got %d %q, want the published key listed", rec.Code, rec.Body.String())
+	}
+
+	form = url.Values{"fingerprint": {testVerifyFingerprint}}
+	req = httptest.NewRequest("POST", "/pks/manage/"+token, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	v.serveRevoke(rec, req, ps)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %q revoking", rec.Code, rec.Body.String())
+	}
+	if published, _ := v.publishedFor(testVerifyFingerprint, ""); len(published) != 0 {
+		t.Errorf("got %d publications after revoking, want 0", len(published))
+	}
+	if len(changed) != 1 || changed[0] != testVerifyFingerprint {
+		t.Errorf("got publication changes %q, want the revoked key", changed)
+	}
+
+	// No link is mailed for an address which is not published.
+	err := v.RequestManage("bob@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case mail := <-srv.mails:
+		t.Errorf("mailed unpublished address %q", mail.to)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestVerifiedStorage(t *testing.T) {
+	key := newTestVerifyKey()
+	v, backend := newTestVerifier(newTestStorage(key), "")
+	backend.PutVerification(&Verification{
+		Token:       "published",
+		Kind:        verificationPublish,
+		Fingerprint: testVerifyFingerprint,
+		Email:       "alice@example.com",
+		Created:     time.Now().UTC(),
+		Verified:    time.Now().UTC(),
+	})
+	backend.PutVerification(&Verification{
+		Token:       "pending",
+		Kind:        verificationPublish,
+		Fingerprint: testVerifyFingerprint,
+		Email:       "bob@example.com",
+		Created:     time.Now().UTC(),
+	})
+	st := v.Storage(v.st)
+
+	keys, err := st.FetchKeys([]string{key.RFingerprint})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("got %d keys, want 1", len(keys))
+	}
+	if uids := userIDKeywords(keys[0]); len(uids) != 1 || uids[0] != "Alice <Alice@example.com>" {
+		t.Errorf("got user IDs %q, want only the verified one", uids)
+	}
+
+	tests := []struct {
+		search []string
+		want   int
+	}{
+		{[]string{"alice"}, 1},
+		{[]string{"bob"}, 0},
+		{[]string{"bob@example.com"}, 0},
+	}
+	for _, test := range tests {
+		rfps, err := st.MatchKeyword(test.search)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rfps) != test.want {
+			t.Errorf("MatchKeyword(%q): got %d keys, want %d", test.search, len(rfps), test.want)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+// vksMaxUploadBytes bounds the size of a VKS upload request.
+const vksMaxUploadBytes = 8 << 20
+
+// vksError is the JSON body of a VKS API error response.
+type vksError struct {
+	Error string `json:"error"`
+}
+
+// vksUploadRequest is the JSON body of a VKS upload request.
+type vksUploadRequest struct {
+	KeyText string `json:"keytext"`
+}
+
+// vksVerifyRequest is the JSON body of a VKS request-verify request.
+type vksVerifyRequest struct {
+	Token     string   `json:"token"`
+	Addresses []string `json:"addresses"`
+	Locale    []string `json:"locale"`
+}
+
+// vksUploadResponse is the JSON body of the response to an upload or
+// request-verify request. Status maps each email address of the key to its
+// publication state.
+type vksUploadResponse struct {
+	KeyFingerprint string            `json:"key_fpr"`
+	Status         map[string]string `json:"status"`
+	Token          string            `json:"token"`
+}
+
+// vks serves the Verifying Keyserver API of keys.openpgp.org, for clients
+// which no longer speak HKP.
+type vks struct {
+	// st is the storage to which uploads are written, and lookups the
+	// view from which keys are served.
+	st      storage.Storage
+	lookups storage.Storage
+
+	// verifier is nil if email verification is not enabled, in which case
+	// every address is published.
+	verifier *Verifier
+	strip    bool
+}
+
+func newVKS(settings *Settings, st, lookups storage.Storage, verifier *Verifier) *vks {
+	return &vks{
+		st:       st,
+		lookups:  lookups,
+		verifier: verifier,
+		strip:    settings.OpenPGP.StripCertifications != "",
+	}
+}
+
+func (h *vks) Register(r *httprouter.Router) {
+	r.GET("/vks/v1/by-fingerprint/:fingerprint", h.byFingerprint)
+	r.GET("/vks/v1/by-keyid/:keyid", h.byKeyID)
+	r.GET("/vks/v1/by-email/:email", h.byEmail)
+	r.POST("/vks/v1/upload", h.upload)
+	r.POST("/vks/v1/request-verify", h.requestVerify)
+}
+
+func vksRespond(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Errorf("failed to write VKS response: %v", err)
+	}
+}
+
+func vksFail(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch errgo.Cause(err) {
+	case storage.ErrKeyNotFound:
+		status = http.StatusNotFound
+	case errBadRequest, ErrInvalidFingerprint, ErrNoSuchUserID, ErrTokenInvalid:
+		status = http.StatusBadRequest
+	case ErrKeyDeleted, ErrKeyRejected, ErrKeyTooLarge:
+		status = http.StatusForbidden
+	}
+	if status == http.StatusInternalServerError {
+		log.Errorf("VKS: %v", errgo.Details(err))
+	}
+	vksRespond(w, status, &vksError{Error: err.Error()})
+}
+
+// serveKeys writes the keys with the given reversed fingerprints as an
+// armored key block.
+func (h *vks) serveKeys(w http.ResponseWriter, rfps []string) {
+	var keys []*openpgp.PrimaryKey
+	if len(rfps) > 0 {
+		var err error
+		keys, err = h.lookups.FetchKeys(rfps)
+		if err != nil && !storage.IsNotFound(err) {
+			vksFail(w, errgo.Mask(err))
+			return
+		}
+	}
+	if len(keys) == 0 {
+		vksFail(w, errgo.WithCausef(nil, storage.ErrKeyNotFound, "key not found"))
+		return
+	}
+	if h.strip {
+		for _, key := range keys {
+			stripCertifications(key)
+		}
+	}
+	w.Header().Set("Content-Type", "application/pgp-keys")
+	err := openpgp.WriteArmoredPackets(w, keys)
+	if err != nil {
+		log.Errorf("failed to write VKS response: %v", err)
+	}
+}
+
+func (h *vks) byFingerprint(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	fp, err := normalizeFingerprint(ps.ByName("fingerprint"))
+	if err != nil {
+		vksFail(w, errgo.Mask(err, errgo.Is(ErrInvalidFingerprint)))
+		return
+	}
+	h.serveKeys(w, []string{ReverseString(fp)})
+}
+
+func (h *vks) byKeyID(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	keyID := normalizeKeyID(ps.ByName("keyid"))
+	if _, err := hex.DecodeString(keyID); err != nil || len(keyID) != 16 {
+		vksFail(w, errgo.WithCausef(nil, errBadRequest, "%q is not a key ID", ps.ByName("keyid")))
+		return
+	}
+	rfps, err := h.lookups.Resolve([]string{ReverseString(keyID)})
+	if err != nil && !storage.IsNotFound(err) {
+		vksFail(w, errgo.Mask(err))
+		return
+	}
+	h.serveKeys(w, rfps)
+}
+
+// byEmail serves the keys with a user ID containing exactly the given email
+// address.
+func (h *vks) byEmail(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	email := strings.ToLower(strings.TrimSpace(ps.ByName("email")))
+	if !strings.Contains(email, "@") {
+		vksFail(w, errgo.WithCausef(nil, errBadRequest, "%q is not an email address", ps.ByName("email")))
+		return
+	}
+	rfps, err := h.lookups.MatchKeyword([]string{email})
+	if err != nil && !storage.IsNotFound(err) {
+		vksFail(w, errgo.Mask(err))
+		return
+	}
+	var keys []*openpgp.PrimaryKey
+	if len(rfps) > 0 {
+		keys, err = h.lookups.FetchKeys(rfps)
+		if err != nil && !storage.IsNotFound(err) {
+			vksFail(w, errgo.Mask(err))
+			return
+		}
+	}
+	var matched []string
+	for _, key := range keys {
+		for _, uid := range key.UserIDs {
+			if uidEmail(uid.Keywords) == email {
+				matched = append(matched, key.RFingerprint)
+				break
+			}
+		}
+	}
+	h.serveKeys(w, matched)
+}
+
+// upload stores a single key submitted as armored keytext. The response
+// reports the publication state of its addresses, and a token with which
+// their verification may be requested.
+func (h *vks) upload(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var body vksUploadRequest
+	err := json.NewDecoder(http.MaxBytesReader(w, req.Body, vksMaxUploadBytes)).Decode(&body)
+	if err != nil {
+		vksFail(w, errgo.WithCausef(err, errBadRequest, "invalid request"))
+		return
+	}
+	results, err := openpgp.ReadArmorKeys(strings.NewReader(body.KeyText))
+	if err != nil {
+		vksFail(w, errgo.WithCausef(err, errBadRequest, "invalid keytext"))
+		return
+	}
+	var keys []*openpgp.PrimaryKey
+	for result := range results {
+		if result.Error != nil {
+			vksFail(w, errgo.WithCausef(result.Error, errBadRequest, "invalid keytext"))
+			return
+		}
+		keys = append(keys, result.PrimaryKey)
+	}
+	if len(keys) != 1 {
+		vksFail(w, errgo.WithCausef(nil, errBadRequest, "expected a single key, got %d", len(keys)))
+		return
+	}
+	key := keys[0]
+	_, err = storage.UpsertKey(h.st, key)
+	if err != nil {
+		vksFail(w, errgo.Mask(err, errgo.Any))
+		return
+	}
+	token := strings.ToUpper(key.Fingerprint())
+	if h.verifier != nil {
+		token, err = h.verifier.UploadToken(key.Fingerprint())
+		if err != nil {
+			vksFail(w, errgo.Mask(err))
+			return
+		}
+	}
+	h.respondStatus(w, key, token)
+}
+
+// requestVerify mails verification links to the given addresses of the key
+// uploaded with the token.
+func (h *vks) requestVerify(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	var body vksVerifyRequest
+	err := json.NewDecoder(http.MaxBytesReader(w, req.Body, vksMaxUploadBytes)).Decode(&body)
+	if err != nil {
+		vksFail(w, errgo.WithCausef(err, errBadRequest, "invalid request"))
+		return
+	}
+	var fp string
+	if h.verifier != nil {
+		fp, err = h.verifier.Uploaded(body.Token)
+		if err != nil {
+			vksFail(w, errgo.Mask(err, errgo.Is(ErrTokenInvalid)))
+			return
+		}
+		for _, email := range body.Addresses {
+			err := h.verifier.Request(fp, email)
+			if err != nil {
+				vksFail(w, errgo.Mask(err, errgo.Is(ErrNoSuchUserID), errgo.Is(errBadRequest), errgo.Is(ErrInvalidFingerprint)))
+				return
+			}
+		}
+	} else {
+		// Without verification, the token is the key's fingerprint and
+		// every address is already published.
+		fp = body.Token
+	}
+	fp, err = normalizeFingerprint(fp)
+	if err != nil {
+		vksFail(w, errgo.WithCausef(nil, ErrTokenInvalid, "invalid or expired token"))
+		return
+	}
+	keys, err := h.st.FetchKeys([]string{ReverseString(fp)})
+	if err != nil && !storage.IsNotFound(err) {
+		vksFail(w, errgo.Mask(err))
+		return
+	}
+	if len(keys) == 0 {
+		vksFail(w, errgo.WithCausef(nil, storage.ErrKeyNotFound, "key not found"))
+		return
+	}
+	h.respondStatus(w, keys[0], body.Token)
+}
+
+func (h *vks) respondStatus(w http.ResponseWriter, key *openpgp.PrimaryKey, token string) {
+	resp := &vksUploadResponse{
+		KeyFingerprint: strings.ToUpper(key.Fingerprint()),
+		Status:         make(map[string]string),
+		Token:          token,
+	}
+	if h.verifier != nil {
+		var err error
+		resp.Status, err = h.verifier.Publication(key)
+		if err != nil {
+			vksFail(w, errgo.Mask(err))
+			return
+		}
+	} else {
+		for _, uid := range key.UserIDs {
+			if email := uidEmail(uid.Keywords); email != "" {
+				resp.Status[email] = PublicationPublished
+			}
+		}
+	}
+	vksRespond(w, http.StatusOK, resp)
+}
@@ -0,0 +1,421 @@
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	webhookPollInterval = 10 * time.Second
+	webhookMinRetry     = 10 * time.Second
+	webhookMaxRetry     = time.Hour
+	webhookTimeout      = 30 * time.Second
+
+	// webhookIncomingDir is the subdirectory of the queue directory holding
+	// changes yet to be matched against the webhooks' filters. Webhook
+	// names cannot start with a dot, so it cannot clash with their queues.
+	webhookIncomingDir = ".incoming"
+
+	// WebhookSignatureHeader carries the hex-encoded HMAC-SHA256 of the
+	// request body, keyed with the webhook's secret.
+	WebhookSignatureHeader = "X-Hockeypuck-Signature"
+	WebhookEventHeader     = "X-Hockeypuck-Event"
+	WebhookDeliveryHeader  = "X-Hockeypuck-Delivery"
+)
+
+// webhookDelivery is a pending notification of a key change to a webhook.
+// It records the changed key as it was when the change was observed.
+type webhookDelivery struct {
+	Event       string    `json:"event"`
+	Time        time.Time `json:"time"`
+	Fingerprint string    `json:"fingerprint"`
+	KeyID       string    `json:"keyID"`
+	OldDigest   string    `json:"oldDigest,omitempty"`
+	NewDigest   string    `json:"newDigest"`
+	UserIDs     []string  `json:"userIDs,omitempty"`
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	LastError   string    `json:"lastError,omitempty"`
+}
+
+// webhookPayload is the JSON body posted to a webhook.
+type webhookPayload struct {
+	ID          string    `json:"id"`
+	Event       string    `json:"event"`
+	Time        time.Time `json:"time"`
+	Fingerprint string    `json:"fingerprint"`
+	KeyID       string    `json:"keyID"`
+	OldDigest   string    `json:"oldDigest,omitempty"`
+	NewDigest   string    `json:"newDigest"`
+	UserIDs     []string  `json:"userIDs,omitempty"`
+}
+
+// webhook is a configured webhook with its own delivery queue, so that a
+// slow or failing endpoint does not hold up deliveries to the others.
+type webhook struct {
+	name     string
+	settings WebhookConfig
+	filter   *keyFilter
+	spool    *spool
+	wake     chan struct{}
+}
+
+// matches returns whether a change to key, or to the deleted key with the
+// given fingerprint if key is nil, is delivered to the webhook.
+func (hook *webhook) matches(key *openpgp.PrimaryKey, fp string) bool {
+	if hook.filter.empty() {
+		return true
+	}
+	if key == nil {
+		// The key is gone, so only its fingerprint can be matched.
+		return hook.filter.matchFingerprint(fp)
+	}
+	return hook.filter.Match(key)
+}
+
+// validWebhookName returns whether name may name a webhook, whose queue is
+// a directory of that name.
+func validWebhookName(name string) bool {
+	if name == "" || name[0] == '.' {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// webhooks posts signed notifications of key changes to configured URLs.
+//
+// Changes are spooled to disk as they are observed, without touching
+// storage, so that ingestion is not held up. A dispatcher then fetches each
+// changed key, matches it against each webhook's filter, and queues a
+// delivery for each matching webhook in a queue of its own. Both queues are
+// kept on disk, so that deliveries are retried after a restart.
+type webhooks struct {
+	st       storage.Storage
+	hooks    []*webhook
+	incoming *spool
+	wake     chan struct{}
+	client   *http.Client
+
+	t tomb.Tomb
+}
+
+func newWebhooks(st storage.Storage, settings *WebhooksConfig) (*webhooks, error) {
+	incoming, err := newSpool(filepath.Join(settings.QueueDir, webhookIncomingDir))
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot open webhook queue %q", settings.QueueDir)
+	}
+	w := &webhooks{
+		st:       st,
+		incoming: incoming,
+		wake:     make(chan struct{}, 1),
+		client:   &http.Client{Timeout: webhookTimeout},
+	}
+	var names []string
+	for name := range settings.Hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		hookSettings := settings.Hooks[name]
+		if !validWebhookName(name) {
+			return nil, errgo.Newf("invalid webhook name %q", name)
+		}
+		if hookSettings.URL == "" {
+			return nil, errgo.Newf("webhook %q has no url", name)
+		}
+		if hookSettings.Secret == "" {
+			return nil, errgo.Newf("webhook %q has no secret", name)
+		}
+		dir := filepath.Join(settings.QueueDir, name)
+		sp, err := newSpool(dir)
+		if err != nil {
+			return nil, errgo.Notef(err, "cannot open webhook queue %q", dir)
+		}
+		w.hooks = append(w.hooks, &webhook{
+			name:     name,
+			settings: hookSettings,
+			filter:   newKeyFilter(hookSettings.Fingerprints, hookSettings.KeyIDs, hookSettings.Domains),
+			spool:    sp,
+			wake:     make(chan struct{}, 1),
+		})
+	}
+	st.Subscribe(w.keyChanged)
+	return w, nil
+}
+
+func (w *webhooks) Start() {
+	w.t.Go(w.dispatchLoop)
+	for _, hook := range w.hooks {
+		hook := hook
+		w.t.Go(func() error {
+			return w.deliverLoop(hook)
+		})
+	}
+}
+
+func (w *webhooks) Stop() error {
+	w.t.Kill(nil)
+	return w.t.Wait()
+}
+
+// queueLen returns the number of pending deliveries to all webhooks,
+// including changes yet to be matched against their filters.
+func (w *webhooks) queueLen() (*int, error) {
+	names, err := w.incoming.List()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	n := len(names)
+	for _, hook := range w.hooks {
+		names, err := hook.spool.List()
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		n += len(names)
+	}
+	return &n, nil
+}
+
+// keyChanged queues a change for dispatch to the webhooks. It is called as
+// keys are written, so it only records the change.
+func (w *webhooks) keyChanged(kc storage.KeyChange) error {
+	event, oldDigest, newDigest, ok := describeKeyChange(kc)
+	if !ok {
+		return nil
+	}
+	d := &webhookDelivery{
+		Event:     event,
+		Time:      time.Now().UTC(),
+		OldDigest: oldDigest,
+		NewDigest: newDigest,
+	}
+	if kd, ok := kc.(KeyDeleted); ok {
+		d.Fingerprint = kd.Fingerprint
+		if len(kd.Fingerprint) == 40 {
+			d.KeyID = kd.Fingerprint[24:]
+		}
+	}
+	err := w.incoming.Put(d)
+	if err != nil {
+		log.Errorf("failed to queue webhook notification of %q: %v", newDigest, err)
+		return nil
+	}
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (w *webhooks) dispatchLoop() error {
+	for {
+		err := w.dispatchPending()
+		if err != nil {
+			log.Errorf("webhooks: %v", err)
+		}
+		select {
+		case <-w.t.Dying():
+			return nil
+		case <-w.wake:
+		case <-time.After(webhookPollInterval):
+		}
+	}
+}
+
+// dispatchPending queues a delivery of each incoming change to each webhook
+// whose filter matches the changed key.
+func (w *webhooks) dispatchPending() error {
+	names, err := w.incoming.List()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	for _, name := range names {
+		select {
+		case <-w.t.Dying():
+			return nil
+		default:
+		}
+
+		var d webhookDelivery
+		err := w.incoming.Get(name, &d)
+		if err != nil {
+			log.Errorf("invalid webhook queue entry %q: %v", name, err)
+			w.incoming.Fail(name)
+			continue
+		}
+		err = w.dispatch(&d)
+		if err != nil {
+			// Storage is unavailable; the change is dispatched later.
+			return errgo.Notef(err, "cannot fetch changed key %q", d.NewDigest)
+		}
+		w.incoming.Remove(name)
+	}
+	return nil
+}
+
+// dispatch fetches the key changed in d and queues d for delivery to each
+// webhook whose filter matches it.
+func (w *webhooks) dispatch(d *webhookDelivery) error {
+	var key *openpgp.PrimaryKey
+	if d.Event != keyEventDeleted {
+		rfps, err := w.st.MatchMD5([]string{d.NewDigest})
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		if len(rfps) == 0 {
+			// The key has been replaced again since; the later change has
+			// its own notification.
+			return nil
+		}
+		keys, err := w.st.FetchKeys(rfps[:1])
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		key = keys[0]
+		d.Fingerprint = key.Fingerprint()
+		d.KeyID = key.KeyID()
+		for _, uid := range key.UserIDs {
+			d.UserIDs = append(d.UserIDs, uid.Keywords)
+		}
+	}
+
+	for _, hook := range w.hooks {
+		if !hook.matches(key, d.Fingerprint) {
+			continue
+		}
+		err := hook.spool.Put(d)
+		if err != nil {
+			log.Errorf("failed to queue webhook %q delivery: %v", hook.name, err)
+			continue
+		}
+		select {
+		case hook.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (w *webhooks) deliverLoop(hook *webhook) error {
+	for {
+		err := w.deliverPending(hook)
+		if err != nil {
+			log.Errorf("webhook %q: %v", hook.name, err)
+		}
+		select {
+		case <-w.t.Dying():
+			return nil
+		case <-hook.wake:
+		case <-time.After(webhookPollInterval):
+		}
+	}
+}
+
+func (w *webhooks) deliverPending(hook *webhook) error {
+	names, err := hook.spool.List()
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	now := time.Now()
+	for _, name := range names {
+		select {
+		case <-w.t.Dying():
+			return nil
+		default:
+		}
+
+		var d webhookDelivery
+		err := hook.spool.Get(name, &d)
+		if err != nil {
+			log.Errorf("invalid webhook %q queue entry %q: %v", hook.name, name, err)
+			hook.spool.Fail(name)
+			continue
+		}
+		if d.NextAttempt.After(now) {
+			continue
+		}
+
+		err = w.deliver(hook, name, &d)
+		if err == nil {
+			hook.spool.Remove(name)
+			continue
+		}
+		d.Attempts++
+		d.LastError = err.Error()
+		maxAttempts := hook.settings.MaxAttempts
+		if maxAttempts == 0 {
+			maxAttempts = DefaultWebhookMaxAttempts
+		}
+		if d.Attempts >= maxAttempts {
+			log.Errorf("giving up webhook %q delivery %q after %d attempts: %v", hook.name, name, d.Attempts, err)
+			hook.spool.Fail(name)
+			continue
+		}
+		log.Warningf("webhook %q delivery %q failed: %v", hook.name, name, err)
+		d.NextAttempt = time.Now().Add(retryBackoff(d.Attempts, webhookMinRetry, webhookMaxRetry))
+		hook.spool.Update(name, &d)
+	}
+	return nil
+}
+
+// deliver posts the delivery to the webhook.
+func (w *webhooks) deliver(hook *webhook, id string, d *webhookDelivery) error {
+	payload := &webhookPayload{
+		ID:          filepath.Base(id),
+		Event:       d.Event,
+		Time:        d.Time,
+		Fingerprint: d.Fingerprint,
+		KeyID:       d.KeyID,
+		OldDigest:   d.OldDigest,
+		NewDigest:   d.NewDigest,
+		UserIDs:     d.UserIDs,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+
+	req, err := http.NewRequest("POST", hook.settings.URL, bytes.NewReader(body))
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, d.Event)
+	req.Header.Set(WebhookDeliveryHeader, payload.ID)
+	mac := hmac.New(sha256.New, []byte(hook.settings.Secret))
+	mac.Write(body)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errgo.Newf("%s returned %s", hook.settings.URL, resp.Status)
+	}
+	return nil
+}
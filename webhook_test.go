@@ -0,0 +1,114 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	"gopkg.in/hockeypuck/openpgp.v1"
+)
+
+func TestWebhooksFilterOnEnqueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-webhooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	key := &openpgp.PrimaryKey{
+		MD5:     "digest1",
+		UserIDs: []*openpgp.UserID{{Keywords: "Alice <alice@example.com>"}},
+	}
+	key.RFingerprint = "rfp1"
+	st := newTestStorage(key)
+	w, err := newWebhooks(st, &WebhooksConfig{
+		QueueDir: dir,
+		Hooks: map[string]WebhookConfig{
+			"all":     {URL: "http://127.0.0.1/all", Secret: "secret"},
+			"example": {URL: "http://127.0.0.1/example", Secret: "secret", Domains: []string{"example.com"}},
+			"other":   {URL: "http://127.0.0.1/other", Secret: "secret", Domains: []string{"example.org"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.keyChanged(storage.KeyAdded{Digest: "digest1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, hook := range w.hooks {
+		if names, _ := hook.spool.List(); len(names) != 0 {
+			t.Fatalf("hook %q: change queued before dispatch", hook.name)
+		}
+	}
+	err = w.dispatchPending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names, _ := w.incoming.List(); len(names) != 0 {
+		t.Errorf("got %d undispatched changes, want 0", len(names))
+	}
+	want := map[string]int{"all": 1, "example": 1, "other": 0}
+	for _, hook := range w.hooks {
+		names, err := hook.spool.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != want[hook.name] {
+			t.Errorf("hook %q: got %d queued deliveries, want %d", hook.name, len(names), want[hook.name])
+		}
+	}
+}
+
+func TestWebhooksDeliverIndependently(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hockeypuck-webhooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	delivered := make(chan string, 1)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		delivered <- req.Header.Get(WebhookEventHeader)
+	}))
+	defer fast.Close()
+
+	key := &openpgp.PrimaryKey{MD5: "digest1"}
+	key.RFingerprint = "rfp1"
+	st := newTestStorage(key)
+	w, err := newWebhooks(st, &WebhooksConfig{
+		QueueDir: dir,
+		Hooks: map[string]WebhookConfig{
+			"a-slow": {URL: slow.URL, Secret: "secret"},
+			"b-fast": {URL: fast.URL, Secret: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Start()
+	defer w.Stop()
+	defer close(release)
+
+	err = w.keyChanged(storage.KeyAdded{Digest: "digest1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-delivered:
+		if event != keyEventAdded {
+			t.Errorf("got event %q, want %q", event, keyEventAdded)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery held up by a slow webhook")
+	}
+}
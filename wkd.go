@@ -0,0 +1,373 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"gopkg.in/errgo.v1"
+	"gopkg.in/hockeypuck/hkp.v1/storage"
+	log "gopkg.in/hockeypuck/logrus.v0"
+	"gopkg.in/hockeypuck/openpgp.v1"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	// wkdIndexChunkSize is the number of keys fetched from storage at a
+	// time while building the WKD index.
+	wkdIndexChunkSize = 100
+
+	// wkdQueueSize is the number of changed keys which may be awaiting
+	// indexing.
+	wkdQueueSize = 1000
+)
+
+// zbase32Alphabet is the z-base-32 alphabet used to encode WKD hashes.
+const zbase32Alphabet = "ybndrfg8ejkmcpqxot1uwisza345h769"
+
+// zbase32 encodes buf in z-base-32, without padding.
+func zbase32(buf []byte) string {
+	var result []byte
+	var bits uint
+	var acc uint
+	for _, b := range buf {
+		acc = acc<<8 | uint(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			result = append(result, zbase32Alphabet[(acc>>bits)&0x1f])
+		}
+	}
+	if bits > 0 {
+		result = append(result, zbase32Alphabet[(acc<<(5-bits))&0x1f])
+	}
+	return string(result)
+}
+
+// wkdHash returns the WKD hash of the local part of an email address.
+func wkdHash(local string) string {
+	sum := sha1.Sum([]byte(strings.ToLower(local)))
+	return zbase32(sum[:])
+}
+
+// splitEmail returns the local part and domain of an email address.
+func splitEmail(email string) (string, string) {
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return email[:i], email[i+1:]
+	}
+	return email, ""
+}
+
+// wkd serves keys by the Web Key Directory protocol for the configured
+// domains, using both the direct method, where the domain is the host of the
+// request, and the advanced method, where it is part of the path.
+//
+// An index from the hashed local part of each address in the configured
+// domains to the keys with user IDs containing it is built from storage in
+// the background when the server starts, and kept up to date as keys
+// change. Until it is built, only requests giving the local part in the l
+// query parameter are answered.
+type wkd struct {
+	settings *WKDConfig
+	domains  map[string]bool
+	strip    bool
+
+	// st is the storage to which keys are written, and lookups the view
+	// from which they are served.
+	st      storage.Storage
+	lookups storage.Storage
+	backend KeyBackend
+	changed chan string
+
+	mu    sync.RWMutex
+	index map[string]map[string]bool
+	keys  map[string][]string
+	ready bool
+
+	t tomb.Tomb
+}
+
+func newWKD(settings *Settings, st, lookups storage.Storage, backend KeyBackend) *wkd {
+	w := &wkd{
+		settings: settings.WKD,
+		domains:  make(map[string]bool),
+		strip:    settings.OpenPGP.StripCertifications != "",
+		st:       st,
+		lookups:  lookups,
+		backend:  backend,
+		changed:  make(chan string, wkdQueueSize),
+		index:    make(map[string]map[string]bool),
+		keys:     make(map[string][]string),
+	}
+	for _, domain := range settings.WKD.Domains {
+		w.domains[strings.ToLower(domain)] = true
+	}
+	st.Subscribe(w.keyChanged)
+	return w
+}
+
+func (w *wkd) Start() {
+	w.t.Go(w.loop)
+}
+
+func (w *wkd) Stop() error {
+	w.t.Kill(nil)
+	return errgo.Mask(w.t.Wait())
+}
+
+func (w *wkd) keyChanged(kc storage.KeyChange) error {
+	if kd, ok := kc.(KeyDeleted); ok {
+		w.mu.Lock()
+		w.unindex(ReverseString(kd.Fingerprint))
+		w.mu.Unlock()
+		return nil
+	}
+	_, _, digest, ok := describeKeyChange(kc)
+	if !ok || digest == "" {
+		return nil
+	}
+	select {
+	case w.changed <- digest:
+	default:
+		log.Warningf("WKD index queue full, not indexing key with digest %s", digest)
+	}
+	return nil
+}
+
+func (w *wkd) loop() error {
+	start := time.Now()
+	err := w.build()
+	if errgo.Cause(err) == tomb.ErrDying {
+		return nil
+	} else if err != nil {
+		log.Errorf("failed to build WKD index: %v", err)
+	} else {
+		w.mu.Lock()
+		w.ready = true
+		n := len(w.keys)
+		w.mu.Unlock()
+		log.Infof("WKD index of %d keys built in %v", n, time.Since(start))
+	}
+	for {
+		select {
+		case <-w.t.Dying():
+			return nil
+		case digest := <-w.changed:
+			err := w.indexDigest(digest)
+			if err != nil {
+				log.Errorf("failed to index key with digest %s for WKD: %v", digest, err)
+			}
+		}
+	}
+}
+
+// build indexes every key in storage. Keys changed meanwhile are indexed
+// again afterwards from the queue.
+func (w *wkd) build() error {
+	var rfps []string
+	flush := func() error {
+		keys, err := w.st.FetchKeys(rfps)
+		rfps = rfps[:0]
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		for _, key := range keys {
+			w.indexKey(key)
+		}
+		return nil
+	}
+	err := w.backend.Fingerprints(func(rfp string) error {
+		select {
+		case <-w.t.Dying():
+			return tomb.ErrDying
+		default:
+		}
+		rfps = append(rfps, rfp)
+		if len(rfps) < wkdIndexChunkSize {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil && len(rfps) > 0 {
+		err = flush()
+	}
+	return errgo.Mask(err, errgo.Is(tomb.ErrDying))
+}
+
+func (w *wkd) indexDigest(digest string) error {
+	rfps, err := w.st.MatchMD5([]string{digest})
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	keys, err := w.st.FetchKeys(rfps)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, key := range keys {
+		w.indexKey(key)
+	}
+	return nil
+}
+
+// indexKey replaces the index entries of key with those of its current user
+// IDs. It must be called with w.mu held.
+func (w *wkd) indexKey(key *openpgp.PrimaryKey) {
+	rfp := key.RFingerprint
+	w.unindex(rfp)
+	seen := make(map[string]bool)
+	for _, uid := range key.UserIDs {
+		local, domain := splitEmail(uidEmail(uid.Keywords))
+		if !w.domains[domain] {
+			continue
+		}
+		entry := domain + "/" + wkdHash(local)
+		if seen[entry] {
+			continue
+		}
+		seen[entry] = true
+		if w.index[entry] == nil {
+			w.index[entry] = make(map[string]bool)
+		}
+		w.index[entry][rfp] = true
+		w.keys[rfp] = append(w.keys[rfp], entry)
+	}
+}
+
+// unindex removes the index entries of the key with the given reversed
+// fingerprint. It must be called with w.mu held.
+func (w *wkd) unindex(rfp string) {
+	for _, entry := range w.keys[rfp] {
+		delete(w.index[entry], rfp)
+		if len(w.index[entry]) == 0 {
+			delete(w.index, entry)
+		}
+	}
+	delete(w.keys, rfp)
+}
+
+// candidates returns the reversed fingerprints of keys which may have user
+// IDs with the given hashed local part in domain. ok is false if they
+// cannot be determined until the index is built.
+func (w *wkd) candidates(domain, hash, local string) ([]string, bool, error) {
+	w.mu.RLock()
+	ready := w.ready
+	var rfps []string
+	for rfp := range w.index[domain+"/"+hash] {
+		rfps = append(rfps, rfp)
+	}
+	w.mu.RUnlock()
+	if ready {
+		return rfps, true, nil
+	}
+	if local == "" || wkdHash(local) != hash {
+		return nil, false, nil
+	}
+	rfps, err := w.st.MatchKeyword([]string{strings.ToLower(local) + "@" + domain})
+	if err != nil {
+		return nil, false, errgo.Mask(err)
+	}
+	return rfps, true, nil
+}
+
+// filterKey removes the user IDs of key other than those with the given
+// hashed local part in domain, returning whether any remain. User
+// attributes are removed, and third-party certifications if stripping is
+// enabled.
+func (w *wkd) filterKey(key *openpgp.PrimaryKey, domain, hash string) bool {
+	var uids []*openpgp.UserID
+	for _, uid := range key.UserIDs {
+		local, uidDomain := splitEmail(uidEmail(uid.Keywords))
+		if uidDomain == domain && wkdHash(local) == hash {
+			uids = append(uids, uid)
+		}
+	}
+	key.UserIDs = uids
+	key.UserAttributes = nil
+	if w.strip {
+		stripCertifications(key)
+	}
+	return len(uids) > 0
+}
+
+// requestHost returns the lower-cased host of a request, without any port.
+func requestHost(req *http.Request) string {
+	host := req.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+// serve handles the paths under /.well-known/openpgpkey: hu/<hash> and
+// policy by the direct method, or <domain>/hu/<hash> and <domain>/policy by
+// the advanced method.
+func (w *wkd) serve(rw http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	parts := strings.Split(strings.Trim(ps.ByName("path"), "/"), "/")
+	domain := requestHost(req)
+	if len(parts) == 3 || (len(parts) == 2 && parts[1] == "policy") {
+		domain, parts = strings.ToLower(parts[0]), parts[1:]
+	}
+	if !w.domains[domain] {
+		http.NotFound(rw, req)
+		return
+	}
+	switch {
+	case len(parts) == 1 && parts[0] == "policy":
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		rw.Write([]byte(w.settings.Policy))
+	case len(parts) == 2 && parts[0] == "hu":
+		w.serveKeys(rw, req, domain, strings.ToLower(parts[1]))
+	default:
+		http.NotFound(rw, req)
+	}
+}
+
+func (w *wkd) serveKeys(rw http.ResponseWriter, req *http.Request, domain, hash string) {
+	rfps, ok, err := w.candidates(domain, hash, req.URL.Query().Get("l"))
+	if err != nil {
+		log.Errorf("WKD lookup of %s in %s: %v", hash, domain, err)
+		http.Error(rw, "internal error", http.StatusInternalServerError)
+		return
+	} else if !ok {
+		rw.Header().Set("Retry-After", "60")
+		http.Error(rw, "index not yet built", http.StatusServiceUnavailable)
+		return
+	}
+	var keys []*openpgp.PrimaryKey
+	if len(rfps) > 0 {
+		keys, err = w.lookups.FetchKeys(rfps)
+		if err != nil && !storage.IsNotFound(err) {
+			log.Errorf("WKD lookup of %s in %s: %v", hash, domain, err)
+			http.Error(rw, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+	var buf bytes.Buffer
+	for _, key := range keys {
+		if !w.filterKey(key, domain, hash) {
+			continue
+		}
+		err := openpgp.WritePackets(&buf, key)
+		if err != nil {
+			log.Errorf("WKD lookup of %s in %s: %v", hash, domain, err)
+			http.Error(rw, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if buf.Len() == 0 {
+		http.NotFound(rw, req)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/octet-stream")
+	rw.Write(buf.Bytes())
+}